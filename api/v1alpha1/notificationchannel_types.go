@@ -0,0 +1,75 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NotificationChannelSpec defines the desired state of NotificationChannel
+// +kubebuilder:validation:XValidation:rule="[has(self.webhook), has(self.slack), has(self.sns)].filter(x, x).size() == 1",message="exactly one of webhook, slack or sns must be set"
+type NotificationChannelSpec struct {
+	// Webhook posts the notifications as JSON to a generic HTTP webhook
+	Webhook *WebhookChannel `json:"webhook,omitempty"`
+
+	// Slack posts the notifications as messages to a Slack-compatible incoming webhook
+	Slack *SlackChannel `json:"slack,omitempty"`
+
+	// SNS publishes the notifications as JSON messages to an SNS topic
+	SNS *SNSChannel `json:"sns,omitempty"`
+}
+
+// WebhookChannel is a generic HTTP webhook receiving notifications as JSON
+type WebhookChannel struct {
+	// URLSecretRef selects the key of a Secret, in the namespace of the channel, holding the URL of the webhook
+	URLSecretRef SecretKeyReference `json:"urlSecretRef"`
+}
+
+// SlackChannel is a Slack-compatible incoming webhook receiving notifications as messages
+type SlackChannel struct {
+	// URLSecretRef selects the key of a Secret, in the namespace of the channel, holding the URL of the incoming webhook
+	URLSecretRef SecretKeyReference `json:"urlSecretRef"`
+}
+
+// SNSChannel is an SNS topic receiving notifications as JSON messages, published with the controller's credentials
+type SNSChannel struct {
+	// TopicArn is the ARN of the topic, which is published to in its own region.
+	// The topic must be allowed by the controller's --sns-topic-arns flag.
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:sns:[a-z0-9-]+:[0-9]{12}:.+$`
+	TopicArn string `json:"topicArn"`
+}
+
+// SecretKeyReference selects a key of a Secret
+type SecretKeyReference struct {
+	// Name is the name of the Secret
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Key is the key of the Secret holding the value
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// NotificationChannel is the Schema for the notificationchannels API.
+// It is a destination of the notifications of the attacks reported on the Protections and ProtectionPolicies
+// that reference it from their namespace.
+type NotificationChannel struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NotificationChannelSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// NotificationChannelList contains a list of NotificationChannel
+type NotificationChannelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationChannel `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NotificationChannel{}, &NotificationChannelList{})
+}
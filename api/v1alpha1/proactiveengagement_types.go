@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProactiveEngagementSpec defines the desired state of ProactiveEngagement
+// +kubebuilder:validation:XValidation:rule="!self.enabled || (has(self.emergencyContacts) && self.emergencyContacts.exists(c, has(c.phoneNumber)))",message="proactive engagement requires an emergency contact with a phone number"
+type ProactiveEngagementSpec struct {
+	// Enabled is whether the Shield Response Team (SRT) contacts the emergency contacts when the health
+	// checks of a protected resource indicate that it is under attack
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// EmergencyContacts are the contacts of the SRT in the account. They replace any contact set outside of the controller.
+	// +kubebuilder:validation:MaxItems=10
+	EmergencyContacts []EmergencyContact `json:"emergencyContacts,omitempty"`
+}
+
+// EmergencyContact is a contact of the Shield Response Team (SRT)
+type EmergencyContact struct {
+	// EmailAddress is the email address of the contact
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=150
+	EmailAddress string `json:"emailAddress"`
+
+	// PhoneNumber is the phone number of the contact, in E.164 format
+	// +kubebuilder:validation:Pattern=`^\+[1-9]\d{1,14}$`
+	PhoneNumber string `json:"phoneNumber,omitempty"`
+
+	// ContactNotes are additional notes about the contact, such as when to reach it
+	// +kubebuilder:validation:MaxLength=1024
+	ContactNotes string `json:"contactNotes,omitempty"`
+}
+
+// ProactiveEngagementStatus defines the observed state of ProactiveEngagement
+type ProactiveEngagementStatus struct {
+	// State is the proactive engagement status in AWS Shield Advanced: ENABLED, DISABLED or PENDING
+	State string `json:"state,omitempty"`
+
+	// EmergencyContacts are the contacts of the SRT currently set in the account
+	EmergencyContacts []EmergencyContact `json:"emergencyContacts,omitempty"`
+
+	ReconcileStatus `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:validation:XValidation:rule="self.metadata.name == 'default'",message="the ProactiveEngagement must be named default"
+//+kubebuilder:printcolumn:name="Enabled",type=boolean,JSONPath=`.spec.enabled`
+//+kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ProactiveEngagement is the Schema for the proactiveengagements API.
+// It represents the emergency contacts and proactive engagement settings of the account of the controller,
+// so there is a single one, named default.
+type ProactiveEngagement struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProactiveEngagementSpec   `json:"spec,omitempty"`
+	Status ProactiveEngagementStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ProactiveEngagementList contains a list of ProactiveEngagement
+type ProactiveEngagementList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProactiveEngagement `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ProactiveEngagement{}, &ProactiveEngagementList{})
+}
@@ -8,18 +8,54 @@ import (
 type ProtectionSpec struct {
 	// The resource ARN to protect with Shield Advanced
 	ResourceArn string `json:"resourceArn,omitempty"`
+
+	// ApplicationLayerAutomaticResponse configures automatic application layer DDoS mitigation
+	// for CloudFront distributions and Application Load Balancers. Left unmanaged when unset.
+	ApplicationLayerAutomaticResponse *ApplicationLayerAutomaticResponse `json:"applicationLayerAutomaticResponse,omitempty"`
+
+	// HealthCheckArns is a list of Route 53 health check ARNs to associate with the protection
+	// for health-based detection. Health checks not in the list are disassociated. Health checks
+	// are left unmanaged when unset, except those previously associated from the list.
+	HealthCheckArns []string `json:"healthCheckArns,omitempty"`
+
+	// DeletionPolicy determines whether the Shield protection is deleted or retained when the Protection
+	// is deleted. Defaults to the controller's default deletion policy when unset.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// AdoptionPolicy determines whether a pre-existing protection of the resource is adopted, in which case
+	// it is managed like a protection created by the controller. Defaults to Never when unset.
+	AdoptionPolicy AdoptionPolicy `json:"adoptionPolicy,omitempty"`
+
+	// NotificationChannelRefs are the channels notified when an attack on the resource starts and ends
+	NotificationChannelRefs []NotificationChannelReference `json:"notificationChannelRefs,omitempty"`
+}
+
+// ProtectionObjectStatus defines the observed state of Protection
+type ProtectionObjectStatus struct {
+	ProtectionStatus `json:",inline"`
+	ReconcileStatus  `json:",inline"`
+
+	// Attacks are the ongoing and recent attacks on the resource, most recent first
+	Attacks []AttackStatus `json:"attacks,omitempty"`
+
+	// ManagedHealthCheckArns are the health checks associated from the spec, which are disassociated
+	// once healthCheckArns is unset
+	ManagedHealthCheckArns []string `json:"managedHealthCheckArns,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Resource",type=string,JSONPath=`.spec.resourceArn`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Protection is the Schema for the protections API
 type Protection struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec   ProtectionSpec   `json:"spec,omitempty"`
-	Status ProtectionStatus `json:"status,omitempty"`
+	Spec   ProtectionSpec         `json:"spec,omitempty"`
+	Status ProtectionObjectStatus `json:"status,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -0,0 +1,105 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProtectionGroupSpec defines the desired state of ProtectionGroup
+// +kubebuilder:validation:XValidation:rule="self.pattern != 'BY_RESOURCE_TYPE' || has(self.resourceType)",message="resourceType is required when pattern is BY_RESOURCE_TYPE"
+// +kubebuilder:validation:XValidation:rule="self.pattern == 'BY_RESOURCE_TYPE' || !has(self.resourceType)",message="resourceType is only allowed when pattern is BY_RESOURCE_TYPE"
+// +kubebuilder:validation:XValidation:rule="self.pattern == 'ARBITRARY' || (!has(self.members) && !has(self.protectionRefs) && !has(self.protectionPolicyRefs))",message="members, protectionRefs and protectionPolicyRefs are only allowed when pattern is ARBITRARY"
+type ProtectionGroupSpec struct {
+
+	// ProtectionGroupId is the name of the protection group in AWS Shield Advanced. It cannot be changed once set.
+	// Defaults to the name of the ProtectionGroup resource, which must then be a valid protection group ID.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9\-]*$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="protectionGroupId is immutable"
+	ProtectionGroupId string `json:"protectionGroupId,omitempty"`
+
+	// Aggregation defines how Shield combines resource data for the group in order to detect, mitigate, and report events
+	// +kubebuilder:validation:Required
+	Aggregation ProtectionGroupAggregation `json:"aggregation"`
+
+	// Pattern defines the criteria used to choose the protected resources for inclusion in the group
+	// +kubebuilder:validation:Required
+	Pattern ProtectionGroupPattern `json:"pattern"`
+
+	// ResourceType is the type of resource to include in the group when the pattern is BY_RESOURCE_TYPE
+	// +kubebuilder:validation:XValidation:rule="self != 'elasticloadbalancing/loadbalancer/net'",message="Network Load Balancers are protected through their Elastic IPs, use ec2/eip instead"
+	ResourceType ResourceType `json:"resourceType,omitempty"`
+
+	// Members is a list of protected resource ARNs to include in the group when the pattern is ARBITRARY
+	Members []string `json:"members,omitempty"`
+
+	// ProtectionRefs is a list of Protection resources in the same namespace whose
+	// resources are included in the group when the pattern is ARBITRARY
+	ProtectionRefs []corev1.LocalObjectReference `json:"protectionRefs,omitempty"`
+
+	// ProtectionPolicyRefs is a list of ProtectionPolicy resources in the same namespace whose
+	// protected resources are included in the group when the pattern is ARBITRARY
+	ProtectionPolicyRefs []corev1.LocalObjectReference `json:"protectionPolicyRefs,omitempty"`
+}
+
+// ProtectionGroupAggregation defines how Shield combines resource data for a protection group
+// +kubebuilder:validation:Enum=SUM;MEAN;MAX
+type ProtectionGroupAggregation string
+
+// ProtectionGroupPattern defines how the members of a protection group are chosen
+// +kubebuilder:validation:Enum=ALL;ARBITRARY;BY_RESOURCE_TYPE
+type ProtectionGroupPattern string
+
+const (
+	// ProtectionGroupPatternAll includes all protected resources in the group
+	ProtectionGroupPatternAll ProtectionGroupPattern = "ALL"
+
+	// ProtectionGroupPatternArbitrary includes an explicit list of protected resources in the group
+	ProtectionGroupPatternArbitrary ProtectionGroupPattern = "ARBITRARY"
+
+	// ProtectionGroupPatternByResourceType includes all protected resources of a single type in the group
+	ProtectionGroupPatternByResourceType ProtectionGroupPattern = "BY_RESOURCE_TYPE"
+)
+
+// ProtectionGroupStatus defines the observed state of ProtectionGroup
+type ProtectionGroupStatus struct {
+	// +kubebuilder:default=Inactive
+	State              ProtectionState `json:"state,omitempty"`
+	ProtectionGroupArn string          `json:"protectionGroupArn,omitempty"`
+	Members            []string        `json:"members,omitempty"`
+
+	// ProtectionGroupId is the ID of the protection group managed in AWS Shield Advanced, which is deleted
+	// along with the resource
+	ProtectionGroupId string `json:"protectionGroupId,omitempty"`
+
+	ReconcileStatus `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+//+kubebuilder:validation:XValidation:rule="has(self.spec.protectionGroupId) || self.metadata.name.matches('^[a-zA-Z0-9-]{1,36}$')",message="protectionGroupId must be set when the name is not a valid protection group ID of at most 36 letters, digits and hyphens"
+
+// ProtectionGroup is the Schema for the protectiongroups API
+type ProtectionGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProtectionGroupSpec   `json:"spec,omitempty"`
+	Status ProtectionGroupStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ProtectionGroupList contains a list of ProtectionGroup
+type ProtectionGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProtectionGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ProtectionGroup{}, &ProtectionGroupList{})
+}
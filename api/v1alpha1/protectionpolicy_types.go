@@ -12,22 +12,229 @@ type ProtectionPolicySpec struct {
 	// +kubebuilder:validation:MinItems=1
 	MatchResourceTypes []ResourceType `json:"matchResourceTypes"`
 
-	// MatchRegions is a list of regions to match
+	// Accounts is a list of AWS accounts in which the policy discovers resources and manages their protections,
+	// each accessed by assuming an IAM role. The account of the controller's own credentials is used when neither
+	// Accounts nor Organization is set.
+	// Protections in an account removed from the list are left in place.
+	Accounts []AccountTarget `json:"accounts,omitempty"`
+
+	// Organization targets the accounts of organizational units of the AWS Organization, in addition to Accounts.
+	// Accounts are listed again on every resync, so that accounts added to the organizational units are picked up.
+	// Protections in accounts that leave the organizational units are left in place.
+	Organization *OrganizationTarget `json:"organization,omitempty"`
+
+	// MatchRegions is a list of regions to match. "*" matches all the regions enabled in each account,
+	// including regions opted in after the policy was created.
 	// +kubebuilder:validation:MinItems=1
 	MatchRegions []string `json:"matchRegions,omitempty"`
+
+	// ExcludeRegions is a list of regions that are never matched, typically used along with "*" in MatchRegions
+	ExcludeRegions []string `json:"excludeRegions,omitempty"`
+
+	// MatchTags selects discovered resources by their AWS tags. All discovered resources match when unset.
+	MatchTags *TagSelector `json:"matchTags,omitempty"`
+
+	// ExcludeTags excludes discovered resources whose AWS tags match the selector. An empty selector excludes nothing.
+	ExcludeTags *TagSelector `json:"excludeTags,omitempty"`
+
+	// ExcludeResourceArns is a list of resource ARNs that are never protected by the policy
+	ExcludeResourceArns []string `json:"excludeResourceArns,omitempty"`
+
+	// ExcludeNamePatterns is a list of patterns matched against the names of discovered resources.
+	// Matching resources are never protected by the policy. Patterns are shell globs, or regular
+	// expressions when prefixed with "regex:".
+	ExcludeNamePatterns []string `json:"excludeNamePatterns,omitempty"`
+
+	// ElasticIPs selects the discovered Elastic IPs, of ec2/eip and of the Network Load Balancers of
+	// elasticloadbalancing/loadbalancer/net, by the resources they are associated with.
+	// All Elastic IPs are matched when unset, including those that are not associated with any resource.
+	ElasticIPs *ElasticIPSelector `json:"elasticIPs,omitempty"`
+
+	// ApplicationLayerAutomaticResponse configures automatic application layer DDoS mitigation
+	// for matched CloudFront distributions and Application Load Balancers. Left unmanaged when unset.
+	ApplicationLayerAutomaticResponse *ApplicationLayerAutomaticResponse `json:"applicationLayerAutomaticResponse,omitempty"`
+
+	// DeletionPolicy determines whether the Shield protections owned by the policy are deleted or retained
+	// when the policy is deleted. Defaults to the controller's default deletion policy when unset.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// AdoptionPolicy determines whether pre-existing protections of matched resources are adopted, in which
+	// case they are managed and pruned like protections created by the controller. Defaults to Never when unset.
+	AdoptionPolicy AdoptionPolicy `json:"adoptionPolicy,omitempty"`
+
+	// NotificationChannelRefs are the channels notified when an attack on a resource protected by the policy
+	// starts and ends
+	NotificationChannelRefs []NotificationChannelReference `json:"notificationChannelRefs,omitempty"`
+}
+
+// AccountTarget identifies an AWS account accessed by assuming an IAM role
+type AccountTarget struct {
+	// RoleArn is the ARN of the IAM role assumed to manage protections in the account
+	// +kubebuilder:validation:Pattern=`^arn:[a-z-]+:iam::[0-9]{12}:role/.+$`
+	RoleArn string `json:"roleArn"`
+
+	// ExternalId is the external ID required by the trust policy of the role, if any
+	ExternalId string `json:"externalId,omitempty"`
 }
 
-// ResourceType identifies the type of resource to match
-// +kubebuilder:validation:Enum=cloudfront/distribution;route53/hostedzone;globalaccelerator/accelerator;ec2/eip;elasticloadbalancing/loadbalancer/app;elasticloadbalancing/loadbalancer/classic
+// OrganizationTarget identifies the accounts of organizational units of an AWS Organization,
+// each accessed by assuming a role of the same name
+type OrganizationTarget struct {
+	// OrganizationalUnitIds is a list of organizational units, or the root, whose accounts are targeted,
+	// including the accounts of nested organizational units
+	// +kubebuilder:validation:MinItems=1
+	OrganizationalUnitIds []string `json:"organizationalUnitIds"`
+
+	// RoleName is the name of the IAM role assumed in each account
+	// +kubebuilder:validation:MinLength=1
+	RoleName string `json:"roleName"`
+
+	// ExternalId is the external ID required by the trust policy of the role, if any
+	ExternalId string `json:"externalId,omitempty"`
+
+	// ExcludeAccountIds is a list of accounts of the organizational units that are not targeted
+	ExcludeAccountIds []string `json:"excludeAccountIds,omitempty"`
+}
+
+// ElasticIPSelector selects Elastic IPs by their association. Elastic IPs that are not associated with any resource
+// never match a selector with AssociationTargets or MatchInstanceTags.
+type ElasticIPSelector struct {
+	// AssociatedOnly matches only the Elastic IPs associated with a resource, so that unattached ones are not protected
+	AssociatedOnly bool `json:"associatedOnly,omitempty"`
+
+	// AssociationTargets matches only the Elastic IPs associated with the given types of resources. Telling NAT
+	// gateways from Network Load Balancers requires the controller to be allowed ec2:DescribeNetworkInterfaces.
+	// +listType=set
+	AssociationTargets []ElasticIPAssociationTarget `json:"associationTargets,omitempty"`
+
+	// MatchInstanceTags matches only the Elastic IPs associated with EC2 instances whose tags match the selector,
+	// which requires the controller to be allowed ec2:DescribeTags
+	MatchInstanceTags *TagSelector `json:"matchInstanceTags,omitempty"`
+}
+
+// ElasticIPAssociationTarget is a type of resource an Elastic IP can be associated with
+// +kubebuilder:validation:Enum=Instance;NATGateway;NetworkLoadBalancer
+type ElasticIPAssociationTarget string
+
+const (
+	// ElasticIPAssociationTargetInstance is an EC2 instance
+	ElasticIPAssociationTargetInstance ElasticIPAssociationTarget = "Instance"
+
+	// ElasticIPAssociationTargetNATGateway is a NAT gateway
+	ElasticIPAssociationTargetNATGateway ElasticIPAssociationTarget = "NATGateway"
+
+	// ElasticIPAssociationTargetNetworkLoadBalancer is a Network Load Balancer
+	ElasticIPAssociationTargetNetworkLoadBalancer ElasticIPAssociationTarget = "NetworkLoadBalancer"
+)
+
+// ResourceType identifies the type of resource to match.
+// Network Load Balancers (elasticloadbalancing/loadbalancer/net) are matched through their Elastic IPs, which are
+// protected instead of the load balancers.
+// +kubebuilder:validation:Enum=cloudfront/distribution;route53/hostedzone;globalaccelerator/accelerator;ec2/eip;elasticloadbalancing/loadbalancer/app;elasticloadbalancing/loadbalancer/classic;elasticloadbalancing/loadbalancer/net
 type ResourceType string
 
+// TagSelector selects AWS resources by their tags, with the semantics of a Kubernetes label selector.
+// All requirements must be satisfied. An empty selector matches every resource.
+type TagSelector struct {
+	// Tags is a map of tag keys to values that a resource must all have
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Expressions is a list of tag selector requirements
+	Expressions []TagSelectorRequirement `json:"expressions,omitempty"`
+}
+
+// TagSelectorRequirement is a requirement on the value of a tag
+// +kubebuilder:validation:XValidation:rule="self.operator in ['Exists', 'DoesNotExist'] ? !has(self.values) : has(self.values) && size(self.values) > 0",message="values must be set for In and NotIn, and unset for Exists and DoesNotExist"
+type TagSelectorRequirement struct {
+	// Key is the tag key the requirement applies to
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Operator is the relationship between the tag and the values
+	Operator TagSelectorOperator `json:"operator"`
+
+	// Values is the set of tag values for the In and NotIn operators
+	Values []string `json:"values,omitempty"`
+}
+
+// TagSelectorOperator is the operator of a tag selector requirement
+// +kubebuilder:validation:Enum=In;NotIn;Exists;DoesNotExist
+type TagSelectorOperator string
+
+const (
+	// TagSelectorOpIn requires the tag to be present with one of the values
+	TagSelectorOpIn TagSelectorOperator = "In"
+
+	// TagSelectorOpNotIn requires the tag to be absent or to have none of the values
+	TagSelectorOpNotIn TagSelectorOperator = "NotIn"
+
+	// TagSelectorOpExists requires the tag to be present
+	TagSelectorOpExists TagSelectorOperator = "Exists"
+
+	// TagSelectorOpDoesNotExist requires the tag to be absent
+	TagSelectorOpDoesNotExist TagSelectorOperator = "DoesNotExist"
+)
+
 // ProtectionPolicyStatus defines the observed state of ProtectionPolicy
 type ProtectionPolicyStatus struct {
 	Protections []ProtectionStatus `json:"protections,omitempty"`
+
+	// ExcludedCount is the number of discovered resources excluded from the policy by its exclusions
+	ExcludedCount int32 `json:"excludedCount,omitempty"`
+
+	// Accounts is the state of the AWS accounts in which the policy manages protections
+	Accounts []AccountStatus `json:"accounts,omitempty"`
+
+	// LastDiscoveryTime is the last time the resources matching the policy were discovered
+	LastDiscoveryTime *metav1.Time `json:"lastDiscoveryTime,omitempty"`
+
+	// Attacks are the ongoing and recent attacks on the resources protected by the policy, most recent first
+	Attacks []AttackStatus `json:"attacks,omitempty"`
+
+	// UnprotectableResources are the resources selected by the policy that AWS Shield Advanced cannot protect,
+	// such as Network Load Balancers without Elastic IPs
+	UnprotectableResources []UnprotectableResourceStatus `json:"unprotectableResources,omitempty"`
+
+	ReconcileStatus `json:",inline"`
+}
+
+// UnprotectableResourceStatus describes a resource selected by a policy that AWS Shield Advanced cannot protect
+type UnprotectableResourceStatus struct {
+	// ResourceArn is the ARN of the resource
+	ResourceArn string `json:"resourceArn"`
+
+	// Name is the name of the resource
+	Name string `json:"name,omitempty"`
+
+	// AccountId is the ID of the AWS account of the resource
+	AccountId string `json:"accountId,omitempty"`
+
+	// Reason explains why the resource cannot be protected
+	Reason string `json:"reason,omitempty"`
+}
+
+// AccountStatus describes an AWS account in which a policy manages protections
+type AccountStatus struct {
+	// AccountId is the ID of the AWS account
+	AccountId string `json:"accountId,omitempty"`
+
+	// RoleArn is the ARN of the IAM role assumed to access the account, unset for the controller's own account
+	RoleArn string `json:"roleArn,omitempty"`
+
+	// DiscoveredCount is the number of resources of the account selected by the policy
+	DiscoveredCount int32 `json:"discoveredCount,omitempty"`
+
+	// ProtectedCount is the number of resources of the account whose protection is active
+	ProtectedCount int32 `json:"protectedCount,omitempty"`
+
+	// Error is the error that prevented the account from being accessed or its resources from being discovered
+	Error string `json:"error,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // ProtectionPolicy is the Schema for the protectionpolicies API
 type ProtectionPolicy struct {
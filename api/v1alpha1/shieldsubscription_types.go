@@ -0,0 +1,107 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ShieldSubscriptionSpec defines the desired state of ShieldSubscription
+type ShieldSubscriptionSpec struct {
+	// ManagementPolicy determines whether the controller only reports the subscription or also creates and updates it.
+	// Creating a subscription commits the account to a paid subscription for its full time commitment.
+	// +kubebuilder:default=Observe
+	ManagementPolicy SubscriptionManagementPolicy `json:"managementPolicy,omitempty"`
+
+	// AutoRenew is whether the subscription is renewed at the end of its current period.
+	// The setting of the subscription is left as is when unset.
+	AutoRenew *bool `json:"autoRenew,omitempty"`
+}
+
+// SubscriptionManagementPolicy determines which changes the controller makes to the Shield Advanced subscription
+// +kubebuilder:validation:Enum=Observe;Manage
+type SubscriptionManagementPolicy string
+
+const (
+	// SubscriptionManagementPolicyObserve only reports the subscription and how it differs from the spec
+	SubscriptionManagementPolicyObserve SubscriptionManagementPolicy = "Observe"
+
+	// SubscriptionManagementPolicyManage creates the subscription when the account has none and updates it to match the spec
+	SubscriptionManagementPolicyManage SubscriptionManagementPolicy = "Manage"
+)
+
+// SubscriptionState describes whether the account has a Shield Advanced subscription
+type SubscriptionState string
+
+const (
+	// SubscriptionStateActive indicates that the account has an active subscription
+	SubscriptionStateActive SubscriptionState = "Active"
+
+	// SubscriptionStateInactive indicates that the account has no active subscription
+	SubscriptionStateInactive SubscriptionState = "Inactive"
+)
+
+// SubscriptionLimit is the maximum number of protected resources of a type
+type SubscriptionLimit struct {
+	// Type is the type of protected resource
+	Type string `json:"type"`
+
+	// Max is the maximum number of protected resources of the type
+	Max int64 `json:"max"`
+}
+
+// ShieldSubscriptionStatus defines the observed state of ShieldSubscription
+type ShieldSubscriptionStatus struct {
+	State SubscriptionState `json:"state,omitempty"`
+
+	// StartTime is when the subscription started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is when the current period of the subscription ends
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// TimeCommitmentInSeconds is the length of a period of the subscription
+	TimeCommitmentInSeconds int64 `json:"timeCommitmentInSeconds,omitempty"`
+
+	// AutoRenew is whether the subscription is renewed at the end of its current period
+	AutoRenew *bool `json:"autoRenew,omitempty"`
+
+	// ProtectionLimits are the maximum numbers of protected resources per resource type
+	ProtectionLimits []SubscriptionLimit `json:"protectionLimits,omitempty"`
+
+	// MaxProtectionGroups is the maximum number of protection groups
+	MaxProtectionGroups int64 `json:"maxProtectionGroups,omitempty"`
+
+	ReconcileStatus `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:validation:XValidation:rule="self.metadata.name == 'default'",message="the ShieldSubscription must be named default"
+//+kubebuilder:printcolumn:name="Policy",type=string,JSONPath=`.spec.managementPolicy`
+//+kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+//+kubebuilder:printcolumn:name="End",type=date,JSONPath=`.status.endTime`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ShieldSubscription is the Schema for the shieldsubscriptions API.
+// It represents the Shield Advanced subscription of the account of the controller, so there is a single one, named default.
+type ShieldSubscription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ShieldSubscriptionSpec   `json:"spec,omitempty"`
+	Status ShieldSubscriptionStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ShieldSubscriptionList contains a list of ShieldSubscription
+type ShieldSubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ShieldSubscription `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ShieldSubscription{}, &ShieldSubscriptionList{})
+}
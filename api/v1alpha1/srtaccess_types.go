@@ -0,0 +1,67 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SRTAccessSpec defines the desired state of SRTAccess
+// +kubebuilder:validation:XValidation:rule="has(self.roleArn) || !has(self.logBuckets) || size(self.logBuckets) == 0",message="logBuckets require roleArn to be set"
+type SRTAccessSpec struct {
+	// RoleArn is the IAM role the Shield Response Team (SRT) assumes to access the account on your behalf.
+	// The role must trust drt.shield.amazonaws.com and have the AWSShieldDRTAccessPolicy managed policy attached.
+	// The role is disassociated when unset.
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`
+	RoleArn string `json:"roleArn,omitempty"`
+
+	// LogBuckets are the S3 buckets holding flow logs the SRT can access.
+	// Buckets not in the list are disassociated.
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=set
+	LogBuckets []string `json:"logBuckets,omitempty"`
+
+	// DeletionPolicy determines whether the SRT access is revoked or retained when the SRTAccess
+	// is deleted. Defaults to the controller's default deletion policy when unset.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// SRTAccessStatus defines the observed state of SRTAccess
+type SRTAccessStatus struct {
+	// RoleArn is the IAM role currently associated with the SRT
+	RoleArn string `json:"roleArn,omitempty"`
+
+	// LogBuckets are the S3 buckets currently associated with the SRT
+	LogBuckets []string `json:"logBuckets,omitempty"`
+
+	ReconcileStatus `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:validation:XValidation:rule="self.metadata.name == 'default'",message="the SRTAccess must be named default"
+//+kubebuilder:printcolumn:name="Role",type=string,JSONPath=`.status.roleArn`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SRTAccess is the Schema for the srtaccesses API.
+// It represents the access of the Shield Response Team to the account of the controller, so there is a single one, named default.
+type SRTAccess struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SRTAccessSpec   `json:"spec,omitempty"`
+	Status SRTAccessStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SRTAccessList contains a list of SRTAccess
+type SRTAccessList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SRTAccess `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SRTAccess{}, &SRTAccessList{})
+}
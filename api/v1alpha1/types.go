@@ -1,11 +1,74 @@
 package v1alpha1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // ProtectionStatus defines the observed state of a protection
 type ProtectionStatus struct {
 	// +kubebuilder:default=Inactive
 	State         ProtectionState `json:"state,omitempty"`
 	ProtectionArn string          `json:"protectionArn,omitempty"`
 	ResourceArn   string          `json:"resourceArn,omitempty"`
+
+	// Name is the name of the protection in AWS Shield Advanced
+	Name string `json:"name,omitempty"`
+
+	// AccountId is the ID of the AWS account in which the protection is managed
+	AccountId string `json:"accountId,omitempty"`
+
+	// Origin records whether the protection was created or adopted by the controller, or is not managed by it
+	Origin ProtectionOrigin `json:"origin,omitempty"`
+
+	// Reason is a machine readable explanation of a Failed state
+	Reason string `json:"reason,omitempty"`
+
+	// Error is the error that caused a Failed state
+	Error string `json:"error,omitempty"`
+
+	// ApplicationLayerAutomaticResponse is the current automatic application layer DDoS mitigation setting
+	ApplicationLayerAutomaticResponse *ApplicationLayerAutomaticResponse `json:"applicationLayerAutomaticResponse,omitempty"`
+
+	// HealthCheckArns are the Route 53 health checks currently associated with the protection
+	HealthCheckArns []string `json:"healthCheckArns,omitempty"`
+}
+
+// AttackStatus describes a DDoS event detected by AWS Shield Advanced on a protected resource
+type AttackStatus struct {
+	// AttackId is the ID of the attack in AWS Shield Advanced
+	AttackId string `json:"attackId"`
+
+	// ResourceArn is the ARN of the attacked resource
+	ResourceArn string `json:"resourceArn"`
+
+	// StartTime is when the attack started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is when the attack ended, unset while the attack is ongoing
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// Vectors are the types of the attack, such as SYN_FLOOD or HTTP_REFLECTION
+	Vectors []string `json:"vectors,omitempty"`
+
+	// Mitigations are the mitigations applied by AWS Shield Advanced
+	Mitigations []string `json:"mitigations,omitempty"`
+
+	// StartPendingChannels are the notification channels that remain to be notified of the start of the attack.
+	// A channel is removed once notified, so that it is not notified again.
+	// +listType=set
+	StartPendingChannels []string `json:"startPendingChannels,omitempty"`
+
+	// EndPendingChannels are the notification channels that remain to be notified of the end of the attack.
+	// A channel is removed once notified, so that it is not notified again.
+	// +listType=set
+	EndPendingChannels []string `json:"endPendingChannels,omitempty"`
+}
+
+// NotificationChannelReference references a NotificationChannel in the namespace of the referencing resource
+type NotificationChannelReference struct {
+	// Name is the name of the NotificationChannel
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
 }
 
 // ProtectionState describes the status of the protection in AWS Shield Advanced.
@@ -17,4 +80,151 @@ const (
 
 	// ProtectionStateInactive indicates that the protection is inactive
 	ProtectionStateInactive ProtectionState = "Inactive"
+
+	// ProtectionStatePending indicates that the protection has not been reconciled yet
+	ProtectionStatePending ProtectionState = "Pending"
+
+	// ProtectionStateFailed indicates that the protection could not be reconciled
+	ProtectionStateFailed ProtectionState = "Failed"
+)
+
+// ApplicationLayerAutomaticResponse configures automatic application layer (L7) DDoS mitigation.
+// It only applies to CloudFront distributions and Application Load Balancers.
+type ApplicationLayerAutomaticResponse struct {
+	// Enabled turns automatic application layer DDoS mitigation on or off
+	Enabled bool `json:"enabled"`
+
+	// Action is the action Shield Advanced applies in the WAF rules it creates in response to an attack
+	// +kubebuilder:default=Count
+	Action ApplicationLayerAutomaticResponseAction `json:"action,omitempty"`
+}
+
+// ApplicationLayerAutomaticResponseAction is the WAF rule action used for automatic mitigation
+// +kubebuilder:validation:Enum=Block;Count
+type ApplicationLayerAutomaticResponseAction string
+
+const (
+	// ApplicationLayerAutomaticResponseActionBlock blocks requests matching the mitigation rules
+	ApplicationLayerAutomaticResponseActionBlock ApplicationLayerAutomaticResponseAction = "Block"
+
+	// ApplicationLayerAutomaticResponseActionCount counts requests matching the mitigation rules
+	ApplicationLayerAutomaticResponseActionCount ApplicationLayerAutomaticResponseAction = "Count"
+)
+
+// ProtectionOrigin describes how a protection came to be managed by the controller
+type ProtectionOrigin string
+
+const (
+	// ProtectionOriginCreated indicates that the protection was created by the controller
+	ProtectionOriginCreated ProtectionOrigin = "Created"
+
+	// ProtectionOriginAdopted indicates that the protection existed beforehand and was adopted by the controller
+	ProtectionOriginAdopted ProtectionOrigin = "Adopted"
+
+	// ProtectionOriginUnmanaged indicates that the protection existed beforehand and was not adopted
+	ProtectionOriginUnmanaged ProtectionOrigin = "Unmanaged"
+)
+
+// AdoptionPolicy determines whether pre-existing Shield protections are adopted by the controller
+// +kubebuilder:validation:Enum=Never;IfUnowned;Always
+type AdoptionPolicy string
+
+const (
+	// AdoptionPolicyNever leaves pre-existing protections unowned, so they are neither deleted nor pruned
+	AdoptionPolicyNever AdoptionPolicy = "Never"
+
+	// AdoptionPolicyIfUnowned adopts pre-existing protections that are not managed by the controller
+	AdoptionPolicyIfUnowned AdoptionPolicy = "IfUnowned"
+
+	// AdoptionPolicyAlways adopts pre-existing protections, including those owned by another resource
+	AdoptionPolicyAlways AdoptionPolicy = "Always"
+)
+
+// DeletionPolicy determines what happens to the Shield protections of a resource when it is deleted
+// +kubebuilder:validation:Enum=Delete;Retain
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the Shield protections owned by the resource
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+
+	// DeletionPolicyRetain keeps the Shield protections in place and removes the controller's ownership tags from them
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+// ReconcileStatus describes the outcome of the most recent reconciliation of a resource
+type ReconcileStatus struct {
+	// ObservedGeneration is the most recent generation observed by the controller
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastSyncTime is the last time the resource was successfully synced with AWS Shield Advanced
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// Message describes the most recent reconciliation failure, if any
+	Message string `json:"message,omitempty"`
+
+	// Conditions describe the current state of the resource
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+const (
+	// ConditionTypeReady indicates that the resource is fully reconciled and its protections are in place
+	ConditionTypeReady = "Ready"
+
+	// ConditionTypeSynced indicates that the most recent reconciliation with AWS Shield Advanced succeeded
+	ConditionTypeSynced = "Synced"
+
+	// ConditionTypeDiscoveryFailed indicates that matching AWS resources could not be discovered
+	ConditionTypeDiscoveryFailed = "DiscoveryFailed"
+
+	// ConditionTypeDegraded indicates that the resource is only partially reconciled
+	ConditionTypeDegraded = "Degraded"
+
+	// ConditionTypeSubscriptionExpiring indicates that the Shield Advanced subscription ends soon and is not renewed automatically
+	ConditionTypeSubscriptionExpiring = "SubscriptionExpiring"
+)
+
+const (
+	// ReasonSucceeded is used when a reconciliation step succeeded
+	ReasonSucceeded = "Succeeded"
+
+	// ReasonDryRun is used when changes are skipped because the controller runs in dry-run mode
+	ReasonDryRun = "DryRun"
+
+	// ReasonShieldAPIError is used when a call to AWS Shield Advanced failed
+	ReasonShieldAPIError = "ShieldAPIError"
+
+	// ReasonDiscoveryError is used when discovering AWS resources failed
+	ReasonDiscoveryError = "DiscoveryError"
+
+	// ReasonAccountError is used when an AWS account cannot be accessed
+	ReasonAccountError = "AccountError"
+
+	// ReasonInvalidResourceArn is used when a resource ARN is malformed or in another partition than the controller's credentials
+	ReasonInvalidResourceArn = "InvalidResourceArn"
+
+	// ReasonInvalidSelector is used when a resource selector cannot be evaluated
+	ReasonInvalidSelector = "InvalidSelector"
+
+	// ReasonProtectionsFailed is used when some protections of a resource could not be reconciled
+	ReasonProtectionsFailed = "ProtectionsFailed"
+
+	// ReasonSubscriptionInactive is used when the account has no active Shield Advanced subscription
+	ReasonSubscriptionInactive = "SubscriptionInactive"
+
+	// ReasonObserveOnly is used when a resource differs from its spec but its management policy only allows observing it
+	ReasonObserveOnly = "ObserveOnly"
+
+	// ReasonSubscriptionExpiring is used when the Shield Advanced subscription ends soon and is not renewed automatically
+	ReasonSubscriptionExpiring = "SubscriptionExpiring"
+
+	// ReasonChangesPending is used when changes applied to AWS Shield Advanced are not reflected by it yet
+	ReasonChangesPending = "ChangesPending"
+
+	// ReasonNoMembers is used when a protection group with the ARBITRARY pattern has no protected members
+	ReasonNoMembers = "NoMembers"
 )
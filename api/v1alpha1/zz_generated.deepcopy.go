@@ -5,30 +5,860 @@
 package v1alpha1
 
 import (
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountStatus) DeepCopyInto(out *AccountStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountStatus.
+func (in *AccountStatus) DeepCopy() *AccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountTarget) DeepCopyInto(out *AccountTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountTarget.
+func (in *AccountTarget) DeepCopy() *AccountTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationLayerAutomaticResponse) DeepCopyInto(out *ApplicationLayerAutomaticResponse) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationLayerAutomaticResponse.
+func (in *ApplicationLayerAutomaticResponse) DeepCopy() *ApplicationLayerAutomaticResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationLayerAutomaticResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttackStatus) DeepCopyInto(out *AttackStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Vectors != nil {
+		in, out := &in.Vectors, &out.Vectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Mitigations != nil {
+		in, out := &in.Mitigations, &out.Mitigations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartPendingChannels != nil {
+		in, out := &in.StartPendingChannels, &out.StartPendingChannels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EndPendingChannels != nil {
+		in, out := &in.EndPendingChannels, &out.EndPendingChannels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttackStatus.
+func (in *AttackStatus) DeepCopy() *AttackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AttackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIPSelector) DeepCopyInto(out *ElasticIPSelector) {
+	*out = *in
+	if in.AssociationTargets != nil {
+		in, out := &in.AssociationTargets, &out.AssociationTargets
+		*out = make([]ElasticIPAssociationTarget, len(*in))
+		copy(*out, *in)
+	}
+	if in.MatchInstanceTags != nil {
+		in, out := &in.MatchInstanceTags, &out.MatchInstanceTags
+		*out = new(TagSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPSelector.
+func (in *ElasticIPSelector) DeepCopy() *ElasticIPSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticIPSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmergencyContact) DeepCopyInto(out *EmergencyContact) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmergencyContact.
+func (in *EmergencyContact) DeepCopy() *EmergencyContact {
+	if in == nil {
+		return nil
+	}
+	out := new(EmergencyContact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannel) DeepCopyInto(out *NotificationChannel) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannel.
+func (in *NotificationChannel) DeepCopy() *NotificationChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationChannel) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannelList) DeepCopyInto(out *NotificationChannelList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationChannel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannelList.
+func (in *NotificationChannelList) DeepCopy() *NotificationChannelList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannelList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationChannelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannelReference) DeepCopyInto(out *NotificationChannelReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannelReference.
+func (in *NotificationChannelReference) DeepCopy() *NotificationChannelReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannelReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannelSpec) DeepCopyInto(out *NotificationChannelSpec) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookChannel)
+		**out = **in
+	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackChannel)
+		**out = **in
+	}
+	if in.SNS != nil {
+		in, out := &in.SNS, &out.SNS
+		*out = new(SNSChannel)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannelSpec.
+func (in *NotificationChannelSpec) DeepCopy() *NotificationChannelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationTarget) DeepCopyInto(out *OrganizationTarget) {
+	*out = *in
+	if in.OrganizationalUnitIds != nil {
+		in, out := &in.OrganizationalUnitIds, &out.OrganizationalUnitIds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeAccountIds != nil {
+		in, out := &in.ExcludeAccountIds, &out.ExcludeAccountIds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationTarget.
+func (in *OrganizationTarget) DeepCopy() *OrganizationTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProactiveEngagement) DeepCopyInto(out *ProactiveEngagement) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProactiveEngagement.
+func (in *ProactiveEngagement) DeepCopy() *ProactiveEngagement {
+	if in == nil {
+		return nil
+	}
+	out := new(ProactiveEngagement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProactiveEngagement) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProactiveEngagementList) DeepCopyInto(out *ProactiveEngagementList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProactiveEngagement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProactiveEngagementList.
+func (in *ProactiveEngagementList) DeepCopy() *ProactiveEngagementList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProactiveEngagementList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProactiveEngagementList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProactiveEngagementSpec) DeepCopyInto(out *ProactiveEngagementSpec) {
+	*out = *in
+	if in.EmergencyContacts != nil {
+		in, out := &in.EmergencyContacts, &out.EmergencyContacts
+		*out = make([]EmergencyContact, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProactiveEngagementSpec.
+func (in *ProactiveEngagementSpec) DeepCopy() *ProactiveEngagementSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProactiveEngagementSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProactiveEngagementStatus) DeepCopyInto(out *ProactiveEngagementStatus) {
+	*out = *in
+	if in.EmergencyContacts != nil {
+		in, out := &in.EmergencyContacts, &out.EmergencyContacts
+		*out = make([]EmergencyContact, len(*in))
+		copy(*out, *in)
+	}
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProactiveEngagementStatus.
+func (in *ProactiveEngagementStatus) DeepCopy() *ProactiveEngagementStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProactiveEngagementStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Protection) DeepCopyInto(out *Protection) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Protection.
+func (in *Protection) DeepCopy() *Protection {
+	if in == nil {
+		return nil
+	}
+	out := new(Protection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Protection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectionGroup) DeepCopyInto(out *ProtectionGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionGroup.
+func (in *ProtectionGroup) DeepCopy() *ProtectionGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectionGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectionGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectionGroupList) DeepCopyInto(out *ProtectionGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProtectionGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionGroupList.
+func (in *ProtectionGroupList) DeepCopy() *ProtectionGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectionGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectionGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectionGroupSpec) DeepCopyInto(out *ProtectionGroupSpec) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProtectionRefs != nil {
+		in, out := &in.ProtectionRefs, &out.ProtectionRefs
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProtectionPolicyRefs != nil {
+		in, out := &in.ProtectionPolicyRefs, &out.ProtectionPolicyRefs
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionGroupSpec.
+func (in *ProtectionGroupSpec) DeepCopy() *ProtectionGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectionGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectionGroupStatus) DeepCopyInto(out *ProtectionGroupStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionGroupStatus.
+func (in *ProtectionGroupStatus) DeepCopy() *ProtectionGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectionGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectionList) DeepCopyInto(out *ProtectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Protection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionList.
+func (in *ProtectionList) DeepCopy() *ProtectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectionObjectStatus) DeepCopyInto(out *ProtectionObjectStatus) {
+	*out = *in
+	in.ProtectionStatus.DeepCopyInto(&out.ProtectionStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+	if in.Attacks != nil {
+		in, out := &in.Attacks, &out.Attacks
+		*out = make([]AttackStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManagedHealthCheckArns != nil {
+		in, out := &in.ManagedHealthCheckArns, &out.ManagedHealthCheckArns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionObjectStatus.
+func (in *ProtectionObjectStatus) DeepCopy() *ProtectionObjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectionObjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectionPolicy) DeepCopyInto(out *ProtectionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionPolicy.
+func (in *ProtectionPolicy) DeepCopy() *ProtectionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectionPolicyList) DeepCopyInto(out *ProtectionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProtectionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionPolicyList.
+func (in *ProtectionPolicyList) DeepCopy() *ProtectionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectionPolicySpec) DeepCopyInto(out *ProtectionPolicySpec) {
+	*out = *in
+	if in.MatchResourceTypes != nil {
+		in, out := &in.MatchResourceTypes, &out.MatchResourceTypes
+		*out = make([]ResourceType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Accounts != nil {
+		in, out := &in.Accounts, &out.Accounts
+		*out = make([]AccountTarget, len(*in))
+		copy(*out, *in)
+	}
+	if in.Organization != nil {
+		in, out := &in.Organization, &out.Organization
+		*out = new(OrganizationTarget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MatchRegions != nil {
+		in, out := &in.MatchRegions, &out.MatchRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeRegions != nil {
+		in, out := &in.ExcludeRegions, &out.ExcludeRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MatchTags != nil {
+		in, out := &in.MatchTags, &out.MatchTags
+		*out = new(TagSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExcludeTags != nil {
+		in, out := &in.ExcludeTags, &out.ExcludeTags
+		*out = new(TagSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExcludeResourceArns != nil {
+		in, out := &in.ExcludeResourceArns, &out.ExcludeResourceArns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeNamePatterns != nil {
+		in, out := &in.ExcludeNamePatterns, &out.ExcludeNamePatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ElasticIPs != nil {
+		in, out := &in.ElasticIPs, &out.ElasticIPs
+		*out = new(ElasticIPSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplicationLayerAutomaticResponse != nil {
+		in, out := &in.ApplicationLayerAutomaticResponse, &out.ApplicationLayerAutomaticResponse
+		*out = new(ApplicationLayerAutomaticResponse)
+		**out = **in
+	}
+	if in.NotificationChannelRefs != nil {
+		in, out := &in.NotificationChannelRefs, &out.NotificationChannelRefs
+		*out = make([]NotificationChannelReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionPolicySpec.
+func (in *ProtectionPolicySpec) DeepCopy() *ProtectionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectionPolicyStatus) DeepCopyInto(out *ProtectionPolicyStatus) {
+	*out = *in
+	if in.Protections != nil {
+		in, out := &in.Protections, &out.Protections
+		*out = make([]ProtectionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Accounts != nil {
+		in, out := &in.Accounts, &out.Accounts
+		*out = make([]AccountStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastDiscoveryTime != nil {
+		in, out := &in.LastDiscoveryTime, &out.LastDiscoveryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Attacks != nil {
+		in, out := &in.Attacks, &out.Attacks
+		*out = make([]AttackStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UnprotectableResources != nil {
+		in, out := &in.UnprotectableResources, &out.UnprotectableResources
+		*out = make([]UnprotectableResourceStatus, len(*in))
+		copy(*out, *in)
+	}
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionPolicyStatus.
+func (in *ProtectionPolicyStatus) DeepCopy() *ProtectionPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectionPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectionSpec) DeepCopyInto(out *ProtectionSpec) {
+	*out = *in
+	if in.ApplicationLayerAutomaticResponse != nil {
+		in, out := &in.ApplicationLayerAutomaticResponse, &out.ApplicationLayerAutomaticResponse
+		*out = new(ApplicationLayerAutomaticResponse)
+		**out = **in
+	}
+	if in.HealthCheckArns != nil {
+		in, out := &in.HealthCheckArns, &out.HealthCheckArns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotificationChannelRefs != nil {
+		in, out := &in.NotificationChannelRefs, &out.NotificationChannelRefs
+		*out = make([]NotificationChannelReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionSpec.
+func (in *ProtectionSpec) DeepCopy() *ProtectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectionStatus) DeepCopyInto(out *ProtectionStatus) {
+	*out = *in
+	if in.ApplicationLayerAutomaticResponse != nil {
+		in, out := &in.ApplicationLayerAutomaticResponse, &out.ApplicationLayerAutomaticResponse
+		*out = new(ApplicationLayerAutomaticResponse)
+		**out = **in
+	}
+	if in.HealthCheckArns != nil {
+		in, out := &in.HealthCheckArns, &out.HealthCheckArns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionStatus.
+func (in *ProtectionStatus) DeepCopy() *ProtectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileStatus) DeepCopyInto(out *ReconcileStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileStatus.
+func (in *ReconcileStatus) DeepCopy() *ReconcileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSChannel) DeepCopyInto(out *SNSChannel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSChannel.
+func (in *SNSChannel) DeepCopy() *SNSChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SRTAccess) DeepCopyInto(out *SRTAccess) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Protection.
-func (in *Protection) DeepCopy() *Protection {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SRTAccess.
+func (in *SRTAccess) DeepCopy() *SRTAccess {
 	if in == nil {
 		return nil
 	}
-	out := new(Protection)
+	out := new(SRTAccess)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Protection) DeepCopyObject() runtime.Object {
+func (in *SRTAccess) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -36,31 +866,31 @@ func (in *Protection) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectionList) DeepCopyInto(out *ProtectionList) {
+func (in *SRTAccessList) DeepCopyInto(out *SRTAccessList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Protection, len(*in))
+		*out = make([]SRTAccess, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionList.
-func (in *ProtectionList) DeepCopy() *ProtectionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SRTAccessList.
+func (in *SRTAccessList) DeepCopy() *SRTAccessList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectionList)
+	out := new(SRTAccessList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProtectionList) DeepCopyObject() runtime.Object {
+func (in *SRTAccessList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -68,7 +898,63 @@ func (in *ProtectionList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectionPolicy) DeepCopyInto(out *ProtectionPolicy) {
+func (in *SRTAccessSpec) DeepCopyInto(out *SRTAccessSpec) {
+	*out = *in
+	if in.LogBuckets != nil {
+		in, out := &in.LogBuckets, &out.LogBuckets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SRTAccessSpec.
+func (in *SRTAccessSpec) DeepCopy() *SRTAccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SRTAccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SRTAccessStatus) DeepCopyInto(out *SRTAccessStatus) {
+	*out = *in
+	if in.LogBuckets != nil {
+		in, out := &in.LogBuckets, &out.LogBuckets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SRTAccessStatus.
+func (in *SRTAccessStatus) DeepCopy() *SRTAccessStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SRTAccessStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyReference.
+func (in *SecretKeyReference) DeepCopy() *SecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShieldSubscription) DeepCopyInto(out *ShieldSubscription) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -76,18 +962,18 @@ func (in *ProtectionPolicy) DeepCopyInto(out *ProtectionPolicy) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionPolicy.
-func (in *ProtectionPolicy) DeepCopy() *ProtectionPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShieldSubscription.
+func (in *ShieldSubscription) DeepCopy() *ShieldSubscription {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectionPolicy)
+	out := new(ShieldSubscription)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProtectionPolicy) DeepCopyObject() runtime.Object {
+func (in *ShieldSubscription) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -95,31 +981,31 @@ func (in *ProtectionPolicy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectionPolicyList) DeepCopyInto(out *ProtectionPolicyList) {
+func (in *ShieldSubscriptionList) DeepCopyInto(out *ShieldSubscriptionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ProtectionPolicy, len(*in))
+		*out = make([]ShieldSubscription, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionPolicyList.
-func (in *ProtectionPolicyList) DeepCopy() *ProtectionPolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShieldSubscriptionList.
+func (in *ShieldSubscriptionList) DeepCopy() *ShieldSubscriptionList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectionPolicyList)
+	out := new(ShieldSubscriptionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProtectionPolicyList) DeepCopyObject() runtime.Object {
+func (in *ShieldSubscriptionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -127,76 +1013,166 @@ func (in *ProtectionPolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectionPolicySpec) DeepCopyInto(out *ProtectionPolicySpec) {
+func (in *ShieldSubscriptionSpec) DeepCopyInto(out *ShieldSubscriptionSpec) {
 	*out = *in
-	if in.MatchResourceTypes != nil {
-		in, out := &in.MatchResourceTypes, &out.MatchResourceTypes
-		*out = make([]ResourceType, len(*in))
-		copy(*out, *in)
+	if in.AutoRenew != nil {
+		in, out := &in.AutoRenew, &out.AutoRenew
+		*out = new(bool)
+		**out = **in
 	}
-	if in.MatchRegions != nil {
-		in, out := &in.MatchRegions, &out.MatchRegions
-		*out = make([]string, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShieldSubscriptionSpec.
+func (in *ShieldSubscriptionSpec) DeepCopy() *ShieldSubscriptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShieldSubscriptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShieldSubscriptionStatus) DeepCopyInto(out *ShieldSubscriptionStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AutoRenew != nil {
+		in, out := &in.AutoRenew, &out.AutoRenew
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProtectionLimits != nil {
+		in, out := &in.ProtectionLimits, &out.ProtectionLimits
+		*out = make([]SubscriptionLimit, len(*in))
 		copy(*out, *in)
 	}
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionPolicySpec.
-func (in *ProtectionPolicySpec) DeepCopy() *ProtectionPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShieldSubscriptionStatus.
+func (in *ShieldSubscriptionStatus) DeepCopy() *ShieldSubscriptionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectionPolicySpec)
+	out := new(ShieldSubscriptionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectionPolicyStatus) DeepCopyInto(out *ProtectionPolicyStatus) {
+func (in *SlackChannel) DeepCopyInto(out *SlackChannel) {
 	*out = *in
-	if in.Protections != nil {
-		in, out := &in.Protections, &out.Protections
-		*out = make([]ProtectionStatus, len(*in))
+	out.URLSecretRef = in.URLSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackChannel.
+func (in *SlackChannel) DeepCopy() *SlackChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionLimit) DeepCopyInto(out *SubscriptionLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionLimit.
+func (in *SubscriptionLimit) DeepCopy() *SubscriptionLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagSelector) DeepCopyInto(out *TagSelector) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Expressions != nil {
+		in, out := &in.Expressions, &out.Expressions
+		*out = make([]TagSelectorRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagSelector.
+func (in *TagSelector) DeepCopy() *TagSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(TagSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagSelectorRequirement) DeepCopyInto(out *TagSelectorRequirement) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionPolicyStatus.
-func (in *ProtectionPolicyStatus) DeepCopy() *ProtectionPolicyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagSelectorRequirement.
+func (in *TagSelectorRequirement) DeepCopy() *TagSelectorRequirement {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectionPolicyStatus)
+	out := new(TagSelectorRequirement)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectionSpec) DeepCopyInto(out *ProtectionSpec) {
+func (in *UnprotectableResourceStatus) DeepCopyInto(out *UnprotectableResourceStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionSpec.
-func (in *ProtectionSpec) DeepCopy() *ProtectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnprotectableResourceStatus.
+func (in *UnprotectableResourceStatus) DeepCopy() *UnprotectableResourceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectionSpec)
+	out := new(UnprotectableResourceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtectionStatus) DeepCopyInto(out *ProtectionStatus) {
+func (in *WebhookChannel) DeepCopyInto(out *WebhookChannel) {
 	*out = *in
+	out.URLSecretRef = in.URLSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectionStatus.
-func (in *ProtectionStatus) DeepCopy() *ProtectionStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookChannel.
+func (in *WebhookChannel) DeepCopy() *WebhookChannel {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtectionStatus)
+	out := new(WebhookChannel)
 	in.DeepCopyInto(out)
 	return out
 }
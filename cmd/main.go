@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
@@ -21,11 +23,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
 
 	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
 	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
 	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
 	"github.com/geode-io/aws-shield-advanced-controller/internal/controller"
+	webhookv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/internal/webhook/v1alpha1"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -44,15 +48,45 @@ func init() {
 func main() {
 	var dryRun bool
 	var policyResyncPeriodSeconds int
+	var protectionResyncPeriodSeconds int
+	var defaultDeletionPolicy string
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var enableWebhooks bool
+	var subscriptionCheckPeriodSeconds int
+	var subscriptionExpiryWarningDays int
+	var subscriptionReadinessCheck bool
+	var snsTopicArns string
+	var snsEndpoint string
+	var attackPollPeriodSeconds int
+	var attackLookbackHours int
 	flag.BoolVar(&dryRun, "dry-run", false,
 		"If set the controller will run in dry-run mode and not make any changes to AWS Shield configurations.")
 	flag.IntVar(&policyResyncPeriodSeconds, "policy-resync-period-seconds", 300,
 		"Policy resync period in seconds")
+	flag.IntVar(&protectionResyncPeriodSeconds, "protection-resync-period-seconds", 300,
+		"Protection resync period in seconds")
+	flag.IntVar(&subscriptionCheckPeriodSeconds, "subscription-check-period-seconds", 300,
+		"Shield Advanced subscription check period in seconds")
+	flag.IntVar(&subscriptionExpiryWarningDays, "subscription-expiry-warning-days", 30,
+		"Number of days before its end that a subscription which is not renewed automatically is reported as expiring")
+	flag.BoolVar(&subscriptionReadinessCheck, "subscription-readiness-check", false,
+		"If set the controller is only ready while the Shield Advanced subscription is active. "+
+			"An unready controller also stops serving the webhooks.")
+	flag.IntVar(&attackPollPeriodSeconds, "attack-poll-period-seconds", 60,
+		"Shield Advanced attack poll period in seconds, or 0 to not report attacks")
+	flag.IntVar(&attackLookbackHours, "attack-lookback-hours", 24,
+		"Number of hours after they started that ended attacks are reported on the status of the resources, attacks already reported are followed until they end")
+	flag.StringVar(&snsTopicArns, "sns-topic-arns", "",
+		"Comma-separated ARNs of the SNS topics that notification channels may publish to, which may contain * wildcards. "+
+			"Channels cannot publish to any topic when empty.")
+	flag.StringVar(&snsEndpoint, "sns-endpoint", "",
+		"Endpoint of the SNS API used to publish notifications, only meant for local testing with SNS-compatible services")
+	flag.StringVar(&defaultDeletionPolicy, "default-deletion-policy", string(shieldawsv1alpha1.DeletionPolicyDelete),
+		"Deletion policy (Delete or Retain) of Protection and ProtectionPolicy resources that do not set one")
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
@@ -62,6 +96,8 @@ func main() {
 		"If set the metrics endpoint is served securely")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"If set, the validating admission webhooks will be served. Requires a serving certificate.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -70,6 +106,13 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	switch shieldawsv1alpha1.DeletionPolicy(defaultDeletionPolicy) {
+	case shieldawsv1alpha1.DeletionPolicyDelete, shieldawsv1alpha1.DeletionPolicyRetain:
+	default:
+		setupLog.Error(fmt.Errorf("invalid deletion policy: %s", defaultDeletionPolicy), "unable to parse flags")
+		os.Exit(1)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -109,8 +152,15 @@ func main() {
 	}
 
 	config := &config.Config{
-		DryRun:               dryRun,
-		PolicyResyncInterval: time.Duration(policyResyncPeriodSeconds) * time.Second,
+		DryRun:                    dryRun,
+		PolicyResyncInterval:      time.Duration(policyResyncPeriodSeconds) * time.Second,
+		ProtectionResyncInterval:  time.Duration(protectionResyncPeriodSeconds) * time.Second,
+		DefaultDeletionPolicy:     shieldawsv1alpha1.DeletionPolicy(defaultDeletionPolicy),
+		SubscriptionCheckInterval: time.Duration(subscriptionCheckPeriodSeconds) * time.Second,
+		SubscriptionExpiryWarning: time.Duration(subscriptionExpiryWarningDays) * 24 * time.Hour,
+		AttackPollInterval:        time.Duration(attackPollPeriodSeconds) * time.Second,
+		AttackLookback:            time.Duration(attackLookbackHours) * time.Hour,
+		SNSTopicArns:              splitList(snsTopicArns),
 	}
 	if config.DryRun {
 		setupLog.Info("running in dry-run mode")
@@ -124,30 +174,116 @@ func main() {
 	}
 
 	awsCache := aws.NewCache(awsCfg)
-	awsCache.Init(context.Background())
+	if err := awsCache.Init(context.Background()); err != nil {
+		setupLog.Error(err, "unable to identify AWS credentials")
+		os.Exit(1)
+	}
 
 	shieldManager := aws.NewShieldManager(awsCfg, awsCache)
-	discoveryClient := aws.NewDiscoveryClient(awsCfg, awsCache)
+	accountManager := aws.NewAccountManager(awsCfg, awsCache)
+
+	// Check the Shield Advanced subscription before reconciling, then periodically
+	subscriptionMonitor := &controller.SubscriptionMonitor{
+		ShieldManager: shieldManager,
+		Config:        config,
+	}
+	subscriptionMonitor.Refresh(ctrl.LoggerInto(context.Background(), setupLog))
+	if err := mgr.Add(subscriptionMonitor); err != nil {
+		setupLog.Error(err, "unable to set up subscription monitor")
+		os.Exit(1)
+	}
 
 	if err = (&controller.ProtectionReconciler{
 		Client:        mgr.GetClient(),
 		Scheme:        mgr.GetScheme(),
 		Config:        config,
 		ShieldManager: shieldManager,
+		Recorder:      controller.NewEventRecorder(mgr.GetEventRecorderFor("protection-controller")),
+		Subscription:  subscriptionMonitor,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Protection")
 		os.Exit(1)
 	}
 	if err = (&controller.ProtectionPolicyReconciler{
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Config:       config,
+		Accounts:     accountManager,
+		Recorder:     controller.NewEventRecorder(mgr.GetEventRecorderFor("protectionpolicy-controller")),
+		Subscription: subscriptionMonitor,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ProtectionPolicy")
+		os.Exit(1)
+	}
+	if err = (&controller.ProtectionGroupReconciler{
 		Client:        mgr.GetClient(),
 		Scheme:        mgr.GetScheme(),
 		Config:        config,
 		ShieldManager: shieldManager,
-		Discovery:     discoveryClient,
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ProtectionPolicy")
+		setupLog.Error(err, "unable to create controller", "controller", "ProtectionGroup")
 		os.Exit(1)
 	}
+	if err = (&controller.ShieldSubscriptionReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Config:        config,
+		ShieldManager: shieldManager,
+		Recorder:      controller.NewEventRecorder(mgr.GetEventRecorderFor("shieldsubscription-controller")),
+		Subscription:  subscriptionMonitor,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ShieldSubscription")
+		os.Exit(1)
+	}
+	if err = (&controller.ProactiveEngagementReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Config:        config,
+		ShieldManager: shieldManager,
+		Recorder:      controller.NewEventRecorder(mgr.GetEventRecorderFor("proactiveengagement-controller")),
+		Subscription:  subscriptionMonitor,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ProactiveEngagement")
+		os.Exit(1)
+	}
+	if err = (&controller.SRTAccessReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Config:        config,
+		ShieldManager: shieldManager,
+		Recorder:      controller.NewEventRecorder(mgr.GetEventRecorderFor("srtaccess-controller")),
+		Subscription:  subscriptionMonitor,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SRTAccess")
+		os.Exit(1)
+	}
+	if config.AttackPollInterval > 0 {
+		if err := mgr.Add(&controller.AttackMonitor{
+			Client:        mgr.GetClient(),
+			APIReader:     mgr.GetAPIReader(),
+			Config:        config,
+			ShieldManager: shieldManager,
+			Recorder:      controller.NewEventRecorder(mgr.GetEventRecorderFor("attack-monitor")),
+			SNS: sns.NewFromConfig(awsCfg, func(o *sns.Options) {
+				if snsEndpoint != "" {
+					o.BaseEndpoint = &snsEndpoint
+				}
+			}),
+		}); err != nil {
+			setupLog.Error(err, "unable to set up attack monitor")
+			os.Exit(1)
+		}
+	}
+	if enableWebhooks {
+		if err = webhookv1alpha1.SetupProtectionWebhookWithManager(mgr, awsCache); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Protection")
+			os.Exit(1)
+		}
+		if err = webhookv1alpha1.SetupProtectionPolicyWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ProtectionPolicy")
+			os.Exit(1)
+		}
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -158,6 +294,12 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if subscriptionReadinessCheck {
+		if err := mgr.AddReadyzCheck("subscription", subscriptionMonitor.Check); err != nil {
+			setupLog.Error(err, "unable to set up subscription check")
+			os.Exit(1)
+		}
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -165,3 +307,14 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// splitList returns the non-empty items of a comma-separated list
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
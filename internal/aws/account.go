@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// RoleSessionName is the session name used when assuming the role of a target account
+var RoleSessionName = "aws-shield-advanced-controller"
+
+// AccountTarget identifies an AWS account accessed by assuming a role.
+// The zero value identifies the account of the controller's own credentials.
+type AccountTarget struct {
+	RoleArn    string
+	ExternalId string
+}
+
+// Account holds the clients used to manage Shield Advanced protections in a single AWS account
+type Account struct {
+	AccountId     string
+	ShieldManager ShieldManager
+	Discovery     DiscoveryClient
+}
+
+type AccountManager interface {
+	// Account returns the account identified by the target, assuming its role unless it was assumed recently
+	Account(ctx context.Context, target AccountTarget) (*Account, error)
+
+	// ListOrganizationAccounts returns the IDs of the active accounts of AWS Organizations organizational units
+	ListOrganizationAccounts(ctx context.Context, parentIds []string) ([]string, error)
+
+	// RoleArn returns the ARN of the role of the given name in an account of the partition of the controller's credentials
+	RoleArn(accountId, roleName string) string
+}
+
+// AccountTTL is how long an account whose role was assumed is cached, after which its role is assumed
+// again so that revoked access and changes of the account are noticed
+var AccountTTL = time.Hour
+
+// AssumeRoleTimeout bounds the assumption of the role of a target, which is shared by concurrent callers and
+// so does not end with the context of any of them
+var AssumeRoleTimeout = 30 * time.Second
+
+type accountManager struct {
+	cfg           aws.Config
+	cache         Cache
+	organizations OrganizationsClient
+	now           func() time.Time
+
+	// assume returns the account of a target by assuming its role
+	assume func(ctx context.Context, target AccountTarget) (*Account, error)
+
+	// assuming deduplicates concurrent assumptions of the role of a target
+	assuming singleflight.Group
+
+	defaultAccount *Account
+
+	mu       sync.Mutex
+	accounts map[string]*cachedAccount
+}
+
+// cachedAccount is an account cached by the role and external ID of its target
+type cachedAccount struct {
+	account *Account
+	expires time.Time
+}
+
+var _ AccountManager = &accountManager{}
+
+// NewAccountManager returns an account manager that accesses target accounts with the given config,
+// and the account of the config itself with the given cache
+func NewAccountManager(cfg aws.Config, cache Cache) AccountManager {
+	m := &accountManager{
+		cfg:            cfg,
+		cache:          cache,
+		organizations:  organizations.NewFromConfig(cfg),
+		now:            time.Now,
+		defaultAccount: newAccount(cfg, cache),
+		accounts:       map[string]*cachedAccount{},
+	}
+	m.assume = m.assumeRole
+	return m
+}
+
+func (m *accountManager) Account(ctx context.Context, target AccountTarget) (*Account, error) {
+	if target == (AccountTarget{}) {
+		return m.defaultAccount, nil
+	}
+
+	key := target.RoleArn + "|" + target.ExternalId
+	if account := m.cached(key); account != nil {
+		return account, nil
+	}
+
+	// Concurrent callers share the assumption of the role of a target, without holding up other targets
+	account, err, _ := m.assuming.Do(key, func() (interface{}, error) {
+		if account := m.cached(key); account != nil {
+			return account, nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), AssumeRoleTimeout)
+		defer cancel()
+		account, err := m.assume(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		now := m.now()
+		for key, cached := range m.accounts {
+			if !now.Before(cached.expires) {
+				delete(m.accounts, key)
+			}
+		}
+		m.accounts[key] = &cachedAccount{
+			account: account,
+			expires: now.Add(AccountTTL),
+		}
+		return account, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return account.(*Account), nil
+}
+
+// cached returns the account cached for the key of a target, or nil when it is missing or expired
+func (m *accountManager) cached(key string) *Account {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cached, ok := m.accounts[key]
+	if !ok || !m.now().Before(cached.expires) {
+		return nil
+	}
+	return cached.account
+}
+
+func (m *accountManager) assumeRole(ctx context.Context, target AccountTarget) (*Account, error) {
+	log := log.FromContext(ctx)
+
+	log.Info("Assuming role of AWS account", "roleArn", target.RoleArn)
+
+	cfg := m.cfg.Copy()
+	cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(m.cfg), target.RoleArn, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = RoleSessionName
+		if target.ExternalId != "" {
+			o.ExternalID = aws.String(target.ExternalId)
+		}
+	}))
+
+	cache := NewCache(cfg)
+	err := cache.Init(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assume role %s: %w", target.RoleArn, err)
+	}
+
+	return newAccount(cfg, cache), nil
+}
+
+func (m *accountManager) RoleArn(accountId, roleName string) string {
+	return fmt.Sprintf("arn:%s:iam::%s:role/%s", m.cache.GetPartition(), accountId, roleName)
+}
+
+func newAccount(cfg aws.Config, cache Cache) *Account {
+	return &Account{
+		AccountId:     cache.GetAccountId(),
+		ShieldManager: NewShieldManager(cfg, cache),
+		Discovery:     NewDiscoveryClient(cfg, cache),
+	}
+}
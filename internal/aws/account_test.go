@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+)
+
+type mockOrganizationsClient struct {
+	mock.Mock
+}
+
+func (m *mockOrganizationsClient) ListAccountsForParent(ctx context.Context, input *organizations.ListAccountsForParentInput, opts ...func(*organizations.Options)) (*organizations.ListAccountsForParentOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*organizations.ListAccountsForParentOutput), args.Error(1)
+}
+
+func (m *mockOrganizationsClient) ListOrganizationalUnitsForParent(ctx context.Context, input *organizations.ListOrganizationalUnitsForParentInput, opts ...func(*organizations.Options)) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*organizations.ListOrganizationalUnitsForParentOutput), args.Error(1)
+}
+
+func TestAccountManager_DefaultAccount(t *testing.T) {
+	mockCache := new(mockAWSCache)
+	mockCache.
+		On("GetAccountId").
+		Return("123456789012").
+		Once()
+
+	manager := NewAccountManager(aws.Config{}, mockCache)
+
+	account, err := manager.Account(context.Background(), AccountTarget{})
+	assert.NoError(t, err)
+	assert.Equal(t, "123456789012", account.AccountId)
+
+	// The default account is never assumed again
+	again, err := manager.Account(context.Background(), AccountTarget{})
+	assert.NoError(t, err)
+	assert.Same(t, account, again)
+
+	mockCache.AssertExpectations(t)
+}
+
+func TestAccountManager_Account(t *testing.T) {
+	now := time.Now()
+	assumed := []AccountTarget{}
+	manager := &accountManager{
+		now:      func() time.Time { return now },
+		accounts: map[string]*cachedAccount{},
+		assume: func(ctx context.Context, target AccountTarget) (*Account, error) {
+			assumed = append(assumed, target)
+			return &Account{AccountId: "210987654321"}, nil
+		},
+	}
+	ctx := context.Background()
+
+	target := AccountTarget{RoleArn: "arn:aws:iam::210987654321:role/shield-controller", ExternalId: "abc"}
+	account, err := manager.Account(ctx, target)
+	assert.NoError(t, err)
+	assert.Equal(t, "210987654321", account.AccountId)
+
+	// The role is only assumed again once the account expired
+	again, err := manager.Account(ctx, target)
+	assert.NoError(t, err)
+	assert.Same(t, account, again)
+	assert.Len(t, assumed, 1)
+
+	now = now.Add(AccountTTL)
+	expired, err := manager.Account(ctx, target)
+	assert.NoError(t, err)
+	assert.NotSame(t, account, expired)
+	assert.Len(t, assumed, 2)
+
+	// Another external ID or role of the same account is cached separately
+	changed := AccountTarget{RoleArn: target.RoleArn, ExternalId: "def"}
+	_, err = manager.Account(ctx, changed)
+	assert.NoError(t, err)
+	assert.Equal(t, changed, assumed[2])
+
+	renamed := AccountTarget{RoleArn: "arn:aws:iam::210987654321:role/shield", ExternalId: "def"}
+	_, err = manager.Account(ctx, renamed)
+	assert.NoError(t, err)
+	assert.Equal(t, renamed, assumed[3])
+	assert.Len(t, manager.accounts, 3)
+
+	again, err = manager.Account(ctx, target)
+	assert.NoError(t, err)
+	assert.Same(t, expired, again)
+	assert.Len(t, assumed, 4)
+
+	// Expired accounts are dropped when another one is cached
+	now = now.Add(AccountTTL)
+	_, err = manager.Account(ctx, changed)
+	assert.NoError(t, err)
+	assert.Len(t, manager.accounts, 1)
+}
+
+func TestAccountManager_Account_CanceledCaller(t *testing.T) {
+	manager := &accountManager{
+		now:      time.Now,
+		accounts: map[string]*cachedAccount{},
+		assume: func(ctx context.Context, target AccountTarget) (*Account, error) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			if _, ok := ctx.Deadline(); !ok {
+				return nil, errors.New("no timeout")
+			}
+			return &Account{AccountId: "210987654321"}, nil
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The assumption shared with other callers is bounded by its own timeout rather than the caller's context
+	account, err := manager.Account(ctx, AccountTarget{RoleArn: "arn:aws:iam::210987654321:role/shield-controller"})
+	assert.NoError(t, err)
+	assert.Equal(t, "210987654321", account.AccountId)
+}
+
+func TestAccountManager_Account_Error(t *testing.T) {
+	calls := 0
+	manager := &accountManager{
+		now:      time.Now,
+		accounts: map[string]*cachedAccount{},
+		assume: func(ctx context.Context, target AccountTarget) (*Account, error) {
+			calls++
+			return nil, errors.New("access denied")
+		},
+	}
+	target := AccountTarget{RoleArn: "arn:aws:iam::210987654321:role/shield-controller"}
+
+	// Failures are not cached
+	for range 2 {
+		_, err := manager.Account(context.Background(), target)
+		assert.EqualError(t, err, "access denied")
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func TestAccountManager_RoleArn(t *testing.T) {
+	manager := &accountManager{cache: &cache{Partition: "aws-cn"}}
+
+	assert.Equal(t, "arn:aws-cn:iam::210987654321:role/shield-controller", manager.RoleArn("210987654321", "shield-controller"))
+}
+
+func TestAccountManager_ListOrganizationAccounts(t *testing.T) {
+	mockClient := new(mockOrganizationsClient)
+	manager := &accountManager{organizations: mockClient}
+	ctx := context.Background()
+
+	mockClient.
+		On("ListAccountsForParent", ctx, &organizations.ListAccountsForParentInput{ParentId: aws.String("ou-root-parent")}, mock.Anything).
+		Return(&organizations.ListAccountsForParentOutput{
+			Accounts: []types.Account{
+				{Id: aws.String("222222222222"), Status: types.AccountStatusActive},
+				{Id: aws.String("333333333333"), Status: types.AccountStatusSuspended},
+			},
+		}, nil).
+		Once()
+	mockClient.
+		On("ListOrganizationalUnitsForParent", ctx, &organizations.ListOrganizationalUnitsForParentInput{ParentId: aws.String("ou-root-parent")}, mock.Anything).
+		Return(&organizations.ListOrganizationalUnitsForParentOutput{
+			OrganizationalUnits: []types.OrganizationalUnit{{Id: aws.String("ou-root-nested")}},
+		}, nil).
+		Once()
+	mockClient.
+		On("ListAccountsForParent", ctx, &organizations.ListAccountsForParentInput{ParentId: aws.String("ou-root-nested")}, mock.Anything).
+		Return(&organizations.ListAccountsForParentOutput{
+			Accounts: []types.Account{{Id: aws.String("111111111111"), Status: types.AccountStatusActive}},
+		}, nil).
+		Once()
+	mockClient.
+		On("ListOrganizationalUnitsForParent", ctx, &organizations.ListOrganizationalUnitsForParentInput{ParentId: aws.String("ou-root-nested")}, mock.Anything).
+		Return(&organizations.ListOrganizationalUnitsForParentOutput{}, nil).
+		Once()
+
+	accountIds, err := manager.ListOrganizationAccounts(ctx, []string{"ou-root-parent"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"111111111111", "222222222222"}, accountIds)
+	mockClient.AssertExpectations(t)
+}
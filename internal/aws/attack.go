@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+	"github.com/aws/aws-sdk-go-v2/service/shield/types"
+)
+
+// ListAttacks returns the attacks on the protected resources of the account that started since the given time,
+// along with the mitigations applied to each of them. Known are the attacks returned previously: those that ended
+// are not described again, and those that were ongoing are followed by their ID until Shield Advanced reports
+// their end, even once they started before the given time.
+func (m *shieldManager) ListAttacks(ctx context.Context, since time.Time, known []Attack) ([]Attack, error) {
+	previous := map[string]Attack{}
+	for _, attack := range known {
+		previous[attack.Id] = attack
+	}
+
+	summaries, err := m.listAttackSummaries(ctx, &types.TimeRange{FromInclusive: aws.Time(since)})
+	if err != nil {
+		return nil, err
+	}
+
+	var attacks []Attack
+	listed := map[string]bool{}
+	for _, summary := range summaries {
+		attack := Attack{
+			Id:          aws.ToString(summary.AttackId),
+			ResourceArn: aws.ToString(summary.ResourceArn),
+			StartTime:   summary.StartTime,
+			EndTime:     summary.EndTime,
+		}
+		for _, vector := range summary.AttackVectors {
+			attack.Vectors = append(attack.Vectors, aws.ToString(vector.VectorType))
+		}
+		listed[attack.Id] = true
+
+		// The mitigations of an attack no longer change once it ended, and are only available in its details
+		if known, ok := previous[attack.Id]; ok && known.EndTime != nil {
+			attack.Mitigations = known.Mitigations
+		} else {
+			detail, err := m.describeAttack(ctx, attack.Id)
+			if err != nil {
+				return nil, err
+			}
+			attack.Mitigations = mitigationNames(detail)
+		}
+
+		attacks = append(attacks, attack)
+	}
+
+	// An attack that was ongoing and is no longer listed started before the given time, and only its details report
+	// whether it ended since
+	for _, attack := range known {
+		if attack.EndTime != nil || listed[attack.Id] {
+			continue
+		}
+
+		detail, err := m.describeAttack(ctx, attack.Id)
+		var notFoundErr *types.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		attack.EndTime = detail.EndTime
+		attack.Mitigations = mitigationNames(detail)
+		attacks = append(attacks, attack)
+	}
+
+	return attacks, nil
+}
+
+// listAttackSummaries lists the attacks that started in the given time range
+func (m *shieldManager) listAttackSummaries(ctx context.Context, startTime *types.TimeRange) ([]types.AttackSummary, error) {
+	var summaries []types.AttackSummary
+
+	paginator := shield.NewListAttacksPaginator(m.client, &shield.ListAttacksInput{StartTime: startTime})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list attacks: %w", err)
+		}
+		summaries = append(summaries, output.AttackSummaries...)
+	}
+
+	return summaries, nil
+}
+
+func (m *shieldManager) describeAttack(ctx context.Context, attackId string) (*types.AttackDetail, error) {
+	output, err := m.client.DescribeAttack(ctx, &shield.DescribeAttackInput{AttackId: aws.String(attackId)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe attack %s: %w", attackId, err)
+	}
+	if output.Attack == nil {
+		return &types.AttackDetail{}, nil
+	}
+	return output.Attack, nil
+}
+
+// mitigationNames returns the names of the mitigations applied to an attack
+func mitigationNames(detail *types.AttackDetail) []string {
+	var names []string
+	for _, mitigation := range detail.Mitigations {
+		names = append(names, aws.ToString(mitigation.MitigationName))
+	}
+	return names
+}
@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+	"github.com/aws/aws-sdk-go-v2/service/shield/types"
+)
+
+func TestAWSShieldManager_ListAttacks(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	startTime := since.Add(time.Hour)
+	endTime := startTime.Add(time.Hour)
+	before := since.Add(-time.Hour)
+	resourceArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/1234567890abcdef"
+
+	mockClient.
+		On("ListAttacks", ctx, &shield.ListAttacksInput{StartTime: &types.TimeRange{FromInclusive: aws.Time(since)}}, mock.Anything).
+		Return(&shield.ListAttacksOutput{AttackSummaries: []types.AttackSummary{
+			{
+				AttackId:      aws.String("new"),
+				ResourceArn:   aws.String(resourceArn),
+				StartTime:     aws.Time(startTime),
+				AttackVectors: []types.AttackVectorDescription{{VectorType: aws.String("SYN_FLOOD")}},
+			},
+			{
+				AttackId:    aws.String("ended"),
+				ResourceArn: aws.String(resourceArn),
+				StartTime:   aws.Time(startTime),
+				EndTime:     aws.Time(endTime),
+			},
+		}}, nil).
+		Once()
+	mockClient.
+		On("DescribeAttack", ctx, &shield.DescribeAttackInput{AttackId: aws.String("new")}, mock.Anything).
+		Return(&shield.DescribeAttackOutput{Attack: &types.AttackDetail{
+			Mitigations: []types.Mitigation{{MitigationName: aws.String("Mitigation")}},
+		}}, nil).
+		Once()
+	mockClient.
+		On("DescribeAttack", ctx, &shield.DescribeAttackInput{AttackId: aws.String("ongoing")}, mock.Anything).
+		Return(&shield.DescribeAttackOutput{Attack: &types.AttackDetail{}}, nil).
+		Once()
+	mockClient.
+		On("DescribeAttack", ctx, &shield.DescribeAttackInput{AttackId: aws.String("unlisted")}, mock.Anything).
+		Return(&shield.DescribeAttackOutput{Attack: &types.AttackDetail{
+			EndTime:     aws.Time(before),
+			Mitigations: []types.Mitigation{{MitigationName: aws.String("Mitigation")}},
+		}}, nil).
+		Once()
+	mockClient.
+		On("DescribeAttack", ctx, &shield.DescribeAttackInput{AttackId: aws.String("purged")}, mock.Anything).
+		Return(&shield.DescribeAttackOutput{}, &types.ResourceNotFoundException{}).
+		Once()
+
+	// The ended attack is not described again, the attacks that were ongoing are described until they end, without
+	// listing the attacks that started before the lookback
+	attacks, err := manager.ListAttacks(ctx, since, []Attack{
+		{Id: "ended", ResourceArn: resourceArn, StartTime: &startTime, EndTime: &endTime, Mitigations: []string{"Known"}},
+		{Id: "ongoing", ResourceArn: resourceArn, StartTime: &before},
+		{Id: "unlisted", ResourceArn: resourceArn, StartTime: &before, Vectors: []string{"UDP_REFLECTION"}},
+		{Id: "purged", ResourceArn: resourceArn, StartTime: &before},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []Attack{
+		{Id: "new", ResourceArn: resourceArn, StartTime: &startTime, Vectors: []string{"SYN_FLOOD"}, Mitigations: []string{"Mitigation"}},
+		{Id: "ended", ResourceArn: resourceArn, StartTime: &startTime, EndTime: &endTime, Mitigations: []string{"Known"}},
+		{Id: "ongoing", ResourceArn: resourceArn, StartTime: &before},
+		{Id: "unlisted", ResourceArn: resourceArn, StartTime: &before, EndTime: &before, Vectors: []string{"UDP_REFLECTION"}, Mitigations: []string{"Mitigation"}},
+	}, attacks)
+
+	mockClient.AssertExpectations(t)
+}
@@ -3,41 +3,76 @@ package aws
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 )
 
+// DefaultPartition is the partition assumed until the caller identity is known
+var DefaultPartition = "aws"
+
+// RegionsTTL is how long the enabled regions of the account are cached, so that opted-in regions are picked up
+var RegionsTTL = time.Hour
+
 type Cache interface {
 	Init(ctx context.Context) error
 	GetAccountId() string
+
+	// GetPartition returns the partition of the caller identity, such as aws, aws-cn or aws-us-gov
+	GetPartition() string
+
+	// GetRegions returns the regions enabled in the account, sorted by name
+	GetRegions(ctx context.Context) ([]string, error)
 }
 
 type STSClient interface {
 	GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error)
 }
 
+type RegionsClient interface {
+	DescribeRegions(ctx context.Context, params *ec2.DescribeRegionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error)
+}
+
 type cache struct {
-	sts STSClient
+	sts     STSClient
+	regions RegionsClient
 
 	AccountId string
+	Partition string
+
+	mu               sync.Mutex
+	enabledRegions   []string
+	regionsExpiresAt time.Time
 }
 
 func NewCache(cfg aws.Config) Cache {
 	stsClient := sts.NewFromConfig(cfg)
 	return &cache{
-		sts: stsClient,
+		sts:     stsClient,
+		regions: ec2.NewFromConfig(cfg),
 	}
 }
 
 func (c *cache) Init(ctx context.Context) error {
-	// init account id
+	// init account id and partition
 	if c.AccountId == "" {
 		output, err := c.sts.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
 		if err != nil {
 			return fmt.Errorf("error caching account ID: %v", err)
 		}
+
+		identity, err := arn.Parse(aws.ToString(output.Arn))
+		if err != nil {
+			return fmt.Errorf("error parsing caller identity ARN: %v", err)
+		}
+
 		c.AccountId = *output.Account
+		c.Partition = identity.Partition
 	}
 
 	return nil
@@ -46,3 +81,36 @@ func (c *cache) Init(ctx context.Context) error {
 func (c *cache) GetAccountId() string {
 	return c.AccountId
 }
+
+func (c *cache) GetPartition() string {
+	if c.Partition == "" {
+		return DefaultPartition
+	}
+	return c.Partition
+}
+
+func (c *cache) GetRegions(ctx context.Context) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.enabledRegions != nil && time.Now().Before(c.regionsExpiresAt) {
+		return c.enabledRegions, nil
+	}
+
+	// Only the regions enabled in the account are described by default
+	output, err := c.regions.DescribeRegions(ctx, &ec2.DescribeRegionsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("error describing enabled regions: %v", err)
+	}
+
+	regions := []string{}
+	for _, region := range output.Regions {
+		regions = append(regions, aws.ToString(region.RegionName))
+	}
+	slices.Sort(regions)
+
+	c.enabledRegions = regions
+	c.regionsExpiresAt = time.Now().Add(RegionsTTL)
+
+	return c.enabledRegions, nil
+}
@@ -3,7 +3,11 @@ package aws
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -18,6 +22,15 @@ func (m *mockSTSClient) GetCallerIdentity(ctx context.Context, params *sts.GetCa
 	return args.Get(0).(*sts.GetCallerIdentityOutput), args.Error(1)
 }
 
+type mockRegionsClient struct {
+	mock.Mock
+}
+
+func (m *mockRegionsClient) DescribeRegions(ctx context.Context, params *ec2.DescribeRegionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error) {
+	args := m.Called(ctx, params, optFns)
+	return args.Get(0).(*ec2.DescribeRegionsOutput), args.Error(1)
+}
+
 func TestAWSCache_Init(t *testing.T) {
 	mockSTS := new(mockSTSClient)
 	cache := &cache{
@@ -29,11 +42,15 @@ func TestAWSCache_Init(t *testing.T) {
 
 	mockSTS.
 		On("GetCallerIdentity", ctx, &sts.GetCallerIdentityInput{}, mock.Anything).
-		Return(&sts.GetCallerIdentityOutput{Account: &accountID}, nil)
+		Return(&sts.GetCallerIdentityOutput{
+			Account: &accountID,
+			Arn:     aws.String("arn:aws-us-gov:iam::123456789012:user/controller"),
+		}, nil)
 
 	err := cache.Init(ctx)
 	assert.NoError(t, err)
 	assert.Equal(t, accountID, cache.AccountId)
+	assert.Equal(t, "aws-us-gov", cache.GetPartition())
 
 	mockSTS.AssertExpectations(t)
 }
@@ -45,4 +62,48 @@ func TestAWSCache_GetAccountId(t *testing.T) {
 	}
 
 	assert.Equal(t, accountID, cache.GetAccountId())
+	assert.Equal(t, DefaultPartition, cache.GetPartition())
+}
+
+func TestAWSCache_GetRegions(t *testing.T) {
+	mockRegions := new(mockRegionsClient)
+	cache := &cache{
+		regions: mockRegions,
+	}
+
+	ctx := context.Background()
+
+	mockRegions.
+		On("DescribeRegions", ctx, &ec2.DescribeRegionsInput{}, mock.Anything).
+		Return(&ec2.DescribeRegionsOutput{Regions: []ec2types.Region{
+			{RegionName: aws.String("us-east-1")},
+			{RegionName: aws.String("eu-west-1")},
+		}}, nil).
+		Once()
+
+	regions, err := cache.GetRegions(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"eu-west-1", "us-east-1"}, regions)
+
+	// Regions are cached until they expire
+	cached, err := cache.GetRegions(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, regions, cached)
+
+	// Regions opted in meanwhile are picked up once expired
+	cache.regionsExpiresAt = time.Now()
+	mockRegions.
+		On("DescribeRegions", ctx, &ec2.DescribeRegionsInput{}, mock.Anything).
+		Return(&ec2.DescribeRegionsOutput{Regions: []ec2types.Region{
+			{RegionName: aws.String("us-east-1")},
+			{RegionName: aws.String("eu-west-1")},
+			{RegionName: aws.String("ap-southeast-7")},
+		}}, nil).
+		Once()
+
+	regions, err = cache.GetRegions(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ap-southeast-7", "eu-west-1", "us-east-1"}, regions)
+
+	mockRegions.AssertExpectations(t)
 }
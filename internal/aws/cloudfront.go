@@ -10,6 +10,7 @@ import (
 
 type CloudFrontClient interface {
 	ListDistributions(ctx context.Context, params *cloudfront.ListDistributionsInput, optFns ...func(*cloudfront.Options)) (*cloudfront.ListDistributionsOutput, error)
+	ListTagsForResource(ctx context.Context, params *cloudfront.ListTagsForResourceInput, optFns ...func(*cloudfront.Options)) (*cloudfront.ListTagsForResourceOutput, error)
 }
 
 type cloudfrontDiscoveryProvider struct {
@@ -35,10 +36,26 @@ func (p *cloudfrontDiscoveryProvider) Discover(ctx context.Context, request *Dis
 		}
 
 		for _, dist := range page.DistributionList.Items {
+			tags := map[string]string{}
+			if request.WithTags {
+				output, err := p.client.ListTagsForResource(ctx, &cloudfront.ListTagsForResourceInput{
+					Resource: dist.ARN,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("error listing tags for CloudFront distribution %s: %v", *dist.Id, err)
+				}
+				if output.Tags != nil {
+					for _, tag := range output.Tags.Items {
+						tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+					}
+				}
+			}
+
 			resources = append(resources, DiscoveredResource{
 				Type: "cloudfront/distribution",
 				Arn:  *dist.ARN,
 				Name: *dist.Id,
+				Tags: tags,
 			})
 		}
 	}
@@ -23,11 +23,12 @@ func NewDiscoveryClient(cfg aws.Config, cache Cache) DiscoveryClient {
 	// Resource types must match the enum defined in the API
 	providers := map[string]DiscoveryProvider{
 		"cloudfront/distribution":                   NewCloudfrontDiscoveryProvider(cfg),
-		"route53/hostedzone":                        NewRoute53DiscoveryProvider(cfg),
+		"route53/hostedzone":                        NewRoute53DiscoveryProvider(cfg, cache),
 		"globalaccelerator/accelerator":             NewGlobalAcceleratorDiscoveryProvider(cfg),
 		"ec2/eip":                                   NewEC2EIPDiscoveryProvider(cfg, cache),
 		"elasticloadbalancing/loadbalancer/app":     NewELBv2DiscoveryProvider(cfg),
 		"elasticloadbalancing/loadbalancer/classic": NewELBDiscoveryProvider(cfg, cache),
+		"elasticloadbalancing/loadbalancer/net":     NewNLBDiscoveryProvider(cfg, cache),
 	}
 
 	return &discoveryClient{
@@ -36,39 +37,96 @@ func NewDiscoveryClient(cfg aws.Config, cache Cache) DiscoveryClient {
 	}
 }
 
+// Discover discovers the requested resource types concurrently. A resource type that fails to be
+// discovered does not fail the others, its error is reported in the response instead.
 func (d *discoveryClient) Discover(ctx context.Context, request *DiscoveryRequest) (*DiscoveryResponse, error) {
 	log := log.FromContext(ctx)
 
+	regions, err := d.resolveRegions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	request = &DiscoveryRequest{
+		ResourceTypes: request.ResourceTypes,
+		Regions:       regions,
+		EIPFilter:     request.EIPFilter,
+		WithTags:      request.WithTags,
+	}
+
 	p := pool.
-		NewWithResults[[]DiscoveredResource]().
-		WithErrors().
+		NewWithResults[*DiscoveryResponse]().
 		WithMaxGoroutines(4)
 
 	for _, typ := range request.ResourceTypes {
 
-		p.Go(func() ([]DiscoveredResource, error) {
+		p.Go(func() *DiscoveryResponse {
 			provider, ok := d.providers[typ]
 			if !ok {
-				return nil, fmt.Errorf("no discovery provider found for resource type: %s", typ)
+				return &DiscoveryResponse{Errors: []error{fmt.Errorf("no discovery provider found for resource type: %s", typ)}}
 			}
 
 			log.V(1).Info("Discovering resource type", "type", typ)
 			resp, err := provider.Discover(ctx, request)
 			if err != nil {
-				return nil, fmt.Errorf("error discovering resource type: %s, error: %v", typ, err)
+				return &DiscoveryResponse{Errors: []error{fmt.Errorf("error discovering resource type: %s, error: %v", typ, err)}}
 			}
 			log.V(1).Info("Discovered resources", "type", typ, "count", len(resp.Resources), "resources", resp.Resources)
 
-			return resp.Resources, nil
+			return resp
 		})
 	}
 
-	groups, err := p.Wait()
-	if err != nil {
-		return nil, err
+	response := &DiscoveryResponse{}
+	discovered := map[string]int{}
+	for _, resp := range p.Wait() {
+		for _, resource := range resp.Resources {
+			// The Elastic IPs of Network Load Balancers are discovered by both resource types, and are described as
+			// Elastic IPs whichever resource type completes first
+			if i, ok := discovered[resource.Arn]; ok {
+				if resource.Type == "ec2/eip" {
+					response.Resources[i] = resource
+				}
+				continue
+			}
+			discovered[resource.Arn] = len(response.Resources)
+			response.Resources = append(response.Resources, resource)
+		}
+		response.Unprotectable = append(response.Unprotectable, resp.Unprotectable...)
+		response.Errors = append(response.Errors, resp.Errors...)
+	}
+
+	return response, nil
+}
+
+// resolveRegions expands AllRegions to the regions enabled in the account and removes the excluded regions
+func (d *discoveryClient) resolveRegions(ctx context.Context, request *DiscoveryRequest) ([]string, error) {
+	regions := request.Regions
+	if slices.Contains(regions, AllRegions) {
+		enabled, err := d.cache.GetRegions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		regions = append(slices.DeleteFunc(slices.Clone(regions), func(region string) bool { return region == AllRegions }), enabled...)
+	}
+
+	var resolved []string
+	for _, region := range regions {
+		if !slices.Contains(request.ExcludeRegions, region) && !slices.Contains(resolved, region) {
+			resolved = append(resolved, region)
+		}
 	}
 
-	return &DiscoveryResponse{
-		Resources: slices.Concat(groups...),
-	}, nil
+	return resolved, nil
+}
+
+// chunk splits items into batches of at most size items, for APIs that limit the number of resources per call
+func chunk[T any](items []T, size int) [][]T {
+	var chunks [][]T
+	for size < len(items) {
+		items, chunks = items[size:], append(chunks, items[:size])
+	}
+	if len(items) > 0 {
+		chunks = append(chunks, items)
+	}
+	return chunks
 }
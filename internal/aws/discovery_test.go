@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockDiscoveryProvider struct {
+	mock.Mock
+}
+
+func (m *mockDiscoveryProvider) Discover(ctx context.Context, request *DiscoveryRequest) (*DiscoveryResponse, error) {
+	args := m.Called(ctx, request)
+	resp, _ := args.Get(0).(*DiscoveryResponse)
+	return resp, args.Error(1)
+}
+
+func TestDiscoveryClient_Discover_PartialFailure(t *testing.T) {
+	ctx := context.Background()
+	request := &DiscoveryRequest{
+		ResourceTypes: []string{"cloudfront/distribution", "route53/hostedzone", "unknown/type"},
+	}
+
+	cloudfront := new(mockDiscoveryProvider)
+	cloudfront.On("Discover", ctx, request).Return(&DiscoveryResponse{
+		Resources: []DiscoveredResource{
+			{Type: "cloudfront/distribution", Arn: "arn:aws:cloudfront::123456789012:distribution/EXAMPLE", Name: "EXAMPLE"},
+		},
+	}, nil)
+
+	route53 := new(mockDiscoveryProvider)
+	route53.On("Discover", ctx, request).Return(nil, errors.New("throttled"))
+
+	client := &discoveryClient{
+		providers: map[string]DiscoveryProvider{
+			"cloudfront/distribution": cloudfront,
+			"route53/hostedzone":      route53,
+		},
+	}
+
+	resp, err := client.Discover(ctx, request)
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Resources, 1)
+	assert.Equal(t, "arn:aws:cloudfront::123456789012:distribution/EXAMPLE", resp.Resources[0].Arn)
+	assert.Len(t, resp.Errors, 2)
+	cloudfront.AssertExpectations(t)
+	route53.AssertExpectations(t)
+}
+
+func TestDiscoveryClient_Discover_ElasticIPOfNetworkLoadBalancer(t *testing.T) {
+	ctx := context.Background()
+	eipArn := "arn:aws:ec2:us-east-1:123456789012:eip-allocation/eipalloc-0123456789abcdef0"
+	request := &DiscoveryRequest{
+		ResourceTypes: []string{"ec2/eip", "elasticloadbalancing/loadbalancer/net"},
+	}
+
+	eip := new(mockDiscoveryProvider)
+	eip.On("Discover", ctx, request).Return(&DiscoveryResponse{
+		Resources: []DiscoveredResource{
+			{Type: "ec2/eip", Arn: eipArn, Name: "203.0.113.10", Tags: map[string]string{"team": "eip"}},
+		},
+	}, nil)
+
+	nlb := new(mockDiscoveryProvider)
+	nlb.On("Discover", ctx, request).Return(&DiscoveryResponse{
+		Resources: []DiscoveredResource{
+			{Type: "elasticloadbalancing/loadbalancer/net", Arn: eipArn, Name: "my-nlb/203.0.113.10", Tags: map[string]string{"team": "nlb"}},
+		},
+	}, nil)
+
+	client := &discoveryClient{
+		providers: map[string]DiscoveryProvider{
+			"ec2/eip":                               eip,
+			"elasticloadbalancing/loadbalancer/net": nlb,
+		},
+	}
+
+	// The Elastic IP is described as such whichever provider completes first
+	for range 10 {
+		resp, err := client.Discover(ctx, request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []DiscoveredResource{
+			{Type: "ec2/eip", Arn: eipArn, Name: "203.0.113.10", Tags: map[string]string{"team": "eip"}},
+		}, resp.Resources)
+	}
+}
+
+func TestDiscoveryClient_Discover_AllRegions(t *testing.T) {
+	ctx := context.Background()
+
+	mockCache := new(mockAWSCache)
+	mockCache.
+		On("GetRegions", ctx).
+		Return([]string{"eu-west-1", "us-east-1", "us-west-2"}, nil).
+		Once()
+
+	eip := new(mockDiscoveryProvider)
+	eip.On("Discover", ctx, &DiscoveryRequest{
+		ResourceTypes: []string{"ec2/eip"},
+		Regions:       []string{"us-east-1", "eu-west-1"},
+		WithTags:      true,
+	}).Return(&DiscoveryResponse{}, nil)
+
+	client := &discoveryClient{
+		providers: map[string]DiscoveryProvider{"ec2/eip": eip},
+		cache:     mockCache,
+	}
+
+	_, err := client.Discover(ctx, &DiscoveryRequest{
+		ResourceTypes:  []string{"ec2/eip"},
+		Regions:        []string{"us-east-1", AllRegions},
+		ExcludeRegions: []string{"us-west-2"},
+		WithTags:       true,
+	})
+
+	assert.NoError(t, err)
+	mockCache.AssertExpectations(t)
+	eip.AssertExpectations(t)
+}
@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+)
+
+func (m *shieldManager) GetDRTAccess(ctx context.Context) (*DRTAccess, error) {
+	output, err := m.client.DescribeDRTAccess(ctx, &shield.DescribeDRTAccessInput{})
+	if err != nil {
+		return nil, fmt.Errorf("error describing DRT access: %v", err)
+	}
+
+	return &DRTAccess{
+		RoleArn:    aws.ToString(output.RoleArn),
+		LogBuckets: output.LogBucketList,
+	}, nil
+}
+
+func (m *shieldManager) AssociateDRTRole(ctx context.Context, roleArn string) error {
+	log := log.FromContext(ctx)
+
+	log.Info("Associating DRT role", "roleArn", roleArn)
+	_, err := m.client.AssociateDRTRole(ctx, &shield.AssociateDRTRoleInput{
+		RoleArn: aws.String(roleArn),
+	})
+	if err != nil {
+		return fmt.Errorf("error associating DRT role %s: %v", roleArn, err)
+	}
+
+	return nil
+}
+
+func (m *shieldManager) DisassociateDRTRole(ctx context.Context) error {
+	log := log.FromContext(ctx)
+
+	log.Info("Disassociating DRT role")
+	_, err := m.client.DisassociateDRTRole(ctx, &shield.DisassociateDRTRoleInput{})
+	if err != nil {
+		return fmt.Errorf("error disassociating DRT role: %v", err)
+	}
+
+	return nil
+}
+
+func (m *shieldManager) AssociateDRTLogBucket(ctx context.Context, bucket string) error {
+	log := log.FromContext(ctx)
+
+	log.Info("Associating DRT log bucket", "bucket", bucket)
+	_, err := m.client.AssociateDRTLogBucket(ctx, &shield.AssociateDRTLogBucketInput{
+		LogBucket: aws.String(bucket),
+	})
+	if err != nil {
+		return fmt.Errorf("error associating DRT log bucket %s: %v", bucket, err)
+	}
+
+	return nil
+}
+
+func (m *shieldManager) DisassociateDRTLogBucket(ctx context.Context, bucket string) error {
+	log := log.FromContext(ctx)
+
+	log.Info("Disassociating DRT log bucket", "bucket", bucket)
+	_, err := m.client.DisassociateDRTLogBucket(ctx, &shield.DisassociateDRTLogBucketInput{
+		LogBucket: aws.String(bucket),
+	})
+	if err != nil {
+		return fmt.Errorf("error disassociating DRT log bucket %s: %v", bucket, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+)
+
+func TestAWSShieldManager_GetDRTAccess(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	mockClient.
+		On("DescribeDRTAccess", ctx, &shield.DescribeDRTAccessInput{}, mock.Anything).
+		Return(&shield.DescribeDRTAccessOutput{
+			RoleArn:       aws.String("arn:aws:iam::123456789012:role/srt-access"),
+			LogBucketList: []string{"flow-logs"},
+		}, nil).
+		Once()
+	mockClient.
+		On("DescribeDRTAccess", ctx, &shield.DescribeDRTAccessInput{}, mock.Anything).
+		Return(&shield.DescribeDRTAccessOutput{}, nil).
+		Once()
+
+	access, err := manager.GetDRTAccess(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &DRTAccess{RoleArn: "arn:aws:iam::123456789012:role/srt-access", LogBuckets: []string{"flow-logs"}}, access)
+
+	// No access granted
+	access, err = manager.GetDRTAccess(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &DRTAccess{}, access)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestAWSShieldManager_AssociateDRTLogBucket(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	mockClient.
+		On("AssociateDRTLogBucket", ctx, &shield.AssociateDRTLogBucketInput{LogBucket: aws.String("flow-logs")}, mock.Anything).
+		Return(&shield.AssociateDRTLogBucketOutput{}, nil).
+		Once()
+
+	err := manager.AssociateDRTLogBucket(ctx, "flow-logs")
+	assert.NoError(t, err)
+
+	mockClient.AssertExpectations(t)
+}
@@ -3,13 +3,47 @@ package aws
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 )
 
+// Types of the resources an Elastic IP can be associated with. They must match the enum defined in the API.
+const (
+	EIPTargetInstance            = "Instance"
+	EIPTargetNATGateway          = "NATGateway"
+	EIPTargetNetworkLoadBalancer = "NetworkLoadBalancer"
+)
+
+// EIPFilter selects Elastic IPs by their association. Elastic IPs that are not associated never match a filter
+// with TargetTypes or MatchInstanceTags. TargetTypes requires ec2:DescribeNetworkInterfaces, and MatchInstanceTags
+// requires ec2:DescribeTags, in addition to the ec2:DescribeAddresses that discovering Elastic IPs requires.
+type EIPFilter struct {
+	// AssociatedOnly skips the Elastic IPs that are not associated with a resource
+	AssociatedOnly bool
+
+	// TargetTypes restricts the Elastic IPs to those associated with the types of resources, any when empty
+	TargetTypes []string
+
+	// MatchInstanceTags restricts the Elastic IPs to those associated with an instance whose tags it matches, when set
+	MatchInstanceTags func(tags map[string]string) (bool, error)
+}
+
+// selectsNetworkLoadBalancers reports whether the filter selects the Elastic IPs of Network Load Balancers, which are
+// associated but not with an instance. A nil filter selects every Elastic IP.
+func (f *EIPFilter) selectsNetworkLoadBalancers() bool {
+	if f == nil {
+		return true
+	}
+	return f.MatchInstanceTags == nil && (len(f.TargetTypes) == 0 || slices.Contains(f.TargetTypes, EIPTargetNetworkLoadBalancer))
+}
+
 type EC2Client interface {
 	DescribeAddresses(ctx context.Context, params *ec2.DescribeAddressesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAddressesOutput, error)
+	DescribeNetworkInterfaces(ctx context.Context, params *ec2.DescribeNetworkInterfacesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error)
+	DescribeTags(ctx context.Context, params *ec2.DescribeTagsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeTagsOutput, error)
 }
 
 type ec2EIPDiscoveryProvider struct {
@@ -37,11 +71,25 @@ func (p *ec2EIPDiscoveryProvider) Discover(ctx context.Context, request *Discove
 			return nil, fmt.Errorf("error describing EC2 Elastic IPs in region %s: %v", region, err)
 		}
 
-		for _, addr := range output.Addresses {
+		addresses := output.Addresses
+		if request.EIPFilter != nil {
+			addresses, err = p.filter(ctx, region, request.EIPFilter, addresses)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, addr := range addresses {
+			tags := map[string]string{}
+			for _, tag := range addr.Tags {
+				tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+
 			resources = append(resources, DiscoveredResource{
 				Type: "ec2/eip",
-				Arn:  fmt.Sprintf("arn:aws:ec2:%s:%s:eip-allocation/%s", region, p.cache.GetAccountId(), *addr.AllocationId),
+				Arn:  fmt.Sprintf("arn:%s:ec2:%s:%s:eip-allocation/%s", p.cache.GetPartition(), region, p.cache.GetAccountId(), *addr.AllocationId),
 				Name: *addr.PublicIp,
+				Tags: tags,
 			})
 		}
 	}
@@ -50,3 +98,138 @@ func (p *ec2EIPDiscoveryProvider) Discover(ctx context.Context, request *Discove
 		Resources: resources,
 	}, nil
 }
+
+// filter returns the addresses of a region selected by the filter. The types of the network interfaces and the tags
+// of the instances the addresses are associated with are only described when the filter requires them.
+func (p *ec2EIPDiscoveryProvider) filter(ctx context.Context, region string, filter *EIPFilter, addresses []types.Address) ([]types.Address, error) {
+	requiresAssociation := filter.AssociatedOnly || len(filter.TargetTypes) > 0 || filter.MatchInstanceTags != nil
+
+	var interfaceIds, instanceIds []string
+	for _, addr := range addresses {
+		if instanceId := aws.ToString(addr.InstanceId); instanceId != "" {
+			instanceIds = append(instanceIds, instanceId)
+		} else if interfaceId := aws.ToString(addr.NetworkInterfaceId); interfaceId != "" {
+			interfaceIds = append(interfaceIds, interfaceId)
+		}
+	}
+
+	var interfaceTypes map[string]types.NetworkInterfaceType
+	if len(filter.TargetTypes) > 0 {
+		var err error
+		if interfaceTypes, err = p.describeInterfaceTypes(ctx, region, interfaceIds); err != nil {
+			return nil, err
+		}
+	}
+
+	var instanceTags map[string]map[string]string
+	if filter.MatchInstanceTags != nil {
+		var err error
+		if instanceTags, err = p.describeInstanceTags(ctx, region, instanceIds); err != nil {
+			return nil, err
+		}
+	}
+
+	selected := []types.Address{}
+	for _, addr := range addresses {
+		instanceId := aws.ToString(addr.InstanceId)
+		interfaceId := aws.ToString(addr.NetworkInterfaceId)
+
+		if requiresAssociation && aws.ToString(addr.AssociationId) == "" && instanceId == "" {
+			continue
+		}
+
+		if len(filter.TargetTypes) > 0 && !slices.Contains(filter.TargetTypes, eipTarget(instanceId, interfaceTypes[interfaceId])) {
+			continue
+		}
+
+		if filter.MatchInstanceTags != nil {
+			if instanceId == "" {
+				continue
+			}
+			matches, err := filter.MatchInstanceTags(instanceTags[instanceId])
+			if err != nil {
+				return nil, err
+			}
+			if !matches {
+				continue
+			}
+		}
+
+		selected = append(selected, addr)
+	}
+
+	return selected, nil
+}
+
+// eipTarget returns the type of the resource an Elastic IP is associated with, from the instance or the type of the
+// network interface it is associated with, or an empty string when the resource is of another type
+func eipTarget(instanceId string, interfaceType types.NetworkInterfaceType) string {
+	switch {
+	case instanceId != "":
+		return EIPTargetInstance
+	case interfaceType == types.NetworkInterfaceTypeNatGateway:
+		return EIPTargetNATGateway
+	case interfaceType == types.NetworkInterfaceTypeNetworkLoadBalancer:
+		return EIPTargetNetworkLoadBalancer
+	}
+	return ""
+}
+
+// describeInterfaceTypes returns the types of the network interfaces of a region by ID
+func (p *ec2EIPDiscoveryProvider) describeInterfaceTypes(ctx context.Context, region string, interfaceIds []string) (map[string]types.NetworkInterfaceType, error) {
+	interfaceTypes := map[string]types.NetworkInterfaceType{}
+
+	// Filters accept at most 200 values per call, and do not fail on interfaces deleted in the meantime unlike IDs
+	for _, batch := range chunk(interfaceIds, 200) {
+		paginator := ec2.NewDescribeNetworkInterfacesPaginator(p.client, &ec2.DescribeNetworkInterfacesInput{
+			Filters: []types.Filter{{Name: aws.String("network-interface-id"), Values: batch}},
+		})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx, func(o *ec2.Options) {
+				o.Region = region
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error describing EC2 network interfaces in region %s: %v", region, err)
+			}
+
+			for _, networkInterface := range output.NetworkInterfaces {
+				interfaceTypes[aws.ToString(networkInterface.NetworkInterfaceId)] = networkInterface.InterfaceType
+			}
+		}
+	}
+
+	return interfaceTypes, nil
+}
+
+// describeInstanceTags returns the tags of the instances of a region by ID
+func (p *ec2EIPDiscoveryProvider) describeInstanceTags(ctx context.Context, region string, instanceIds []string) (map[string]map[string]string, error) {
+	instanceTags := map[string]map[string]string{}
+	for _, instanceId := range instanceIds {
+		instanceTags[instanceId] = map[string]string{}
+	}
+
+	for _, batch := range chunk(instanceIds, 200) {
+		paginator := ec2.NewDescribeTagsPaginator(p.client, &ec2.DescribeTagsInput{
+			Filters: []types.Filter{
+				{Name: aws.String("resource-type"), Values: []string{"instance"}},
+				{Name: aws.String("resource-id"), Values: batch},
+			},
+		})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx, func(o *ec2.Options) {
+				o.Region = region
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error describing EC2 instance tags in region %s: %v", region, err)
+			}
+
+			for _, tag := range output.Tags {
+				if tags, ok := instanceTags[aws.ToString(tag.ResourceId)]; ok {
+					tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+				}
+			}
+		}
+	}
+
+	return instanceTags, nil
+}
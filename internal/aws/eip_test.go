@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type mockEC2Client struct {
+	mock.Mock
+}
+
+func (m *mockEC2Client) DescribeAddresses(ctx context.Context, input *ec2.DescribeAddressesInput, opts ...func(*ec2.Options)) (*ec2.DescribeAddressesOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*ec2.DescribeAddressesOutput), args.Error(1)
+}
+
+func (m *mockEC2Client) DescribeNetworkInterfaces(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*ec2.DescribeNetworkInterfacesOutput), args.Error(1)
+}
+
+func (m *mockEC2Client) DescribeTags(ctx context.Context, input *ec2.DescribeTagsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTagsOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*ec2.DescribeTagsOutput), args.Error(1)
+}
+
+func TestEC2EIPDiscoveryProvider_DiscoverFiltered(t *testing.T) {
+	ctx := context.Background()
+	addresses := []types.Address{
+		{AllocationId: aws.String("eipalloc-unattached"), PublicIp: aws.String("198.51.100.1")},
+		{AllocationId: aws.String("eipalloc-web"), PublicIp: aws.String("198.51.100.2"), AssociationId: aws.String("eipassoc-1"),
+			InstanceId: aws.String("i-web"), NetworkInterfaceId: aws.String("eni-web")},
+		{AllocationId: aws.String("eipalloc-batch"), PublicIp: aws.String("198.51.100.3"), AssociationId: aws.String("eipassoc-2"),
+			InstanceId: aws.String("i-batch"), NetworkInterfaceId: aws.String("eni-batch")},
+		{AllocationId: aws.String("eipalloc-nat"), PublicIp: aws.String("198.51.100.4"), AssociationId: aws.String("eipassoc-3"),
+			NetworkInterfaceId: aws.String("eni-nat")},
+		{AllocationId: aws.String("eipalloc-nlb"), PublicIp: aws.String("198.51.100.5"), AssociationId: aws.String("eipassoc-4"),
+			NetworkInterfaceId: aws.String("eni-nlb")},
+	}
+
+	tests := []struct {
+		name     string
+		filter   *EIPFilter
+		expected []string
+	}{
+		{
+			name:     "no filter",
+			expected: []string{"198.51.100.1", "198.51.100.2", "198.51.100.3", "198.51.100.4", "198.51.100.5"},
+		},
+		{
+			name:     "associated only",
+			filter:   &EIPFilter{AssociatedOnly: true},
+			expected: []string{"198.51.100.2", "198.51.100.3", "198.51.100.4", "198.51.100.5"},
+		},
+		{
+			name:     "association targets",
+			filter:   &EIPFilter{TargetTypes: []string{EIPTargetNATGateway, EIPTargetNetworkLoadBalancer}},
+			expected: []string{"198.51.100.4", "198.51.100.5"},
+		},
+		{
+			name: "instance tags",
+			filter: &EIPFilter{MatchInstanceTags: func(tags map[string]string) (bool, error) {
+				return tags["role"] == "web", nil
+			}},
+			expected: []string{"198.51.100.2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mockEC2Client)
+			mockCache := new(mockAWSCache)
+			provider := &ec2EIPDiscoveryProvider{client: mockClient, cache: mockCache}
+
+			mockCache.On("GetPartition").Return("aws")
+			mockCache.On("GetAccountId").Return("123456789012")
+			mockClient.
+				On("DescribeAddresses", ctx, &ec2.DescribeAddressesInput{}, mock.Anything).
+				Return(&ec2.DescribeAddressesOutput{Addresses: addresses}, nil).
+				Once()
+			if tt.filter != nil && len(tt.filter.TargetTypes) > 0 {
+				mockClient.
+					On("DescribeNetworkInterfaces", ctx, &ec2.DescribeNetworkInterfacesInput{
+						Filters: []types.Filter{{Name: aws.String("network-interface-id"), Values: []string{"eni-nat", "eni-nlb"}}},
+					}, mock.Anything).
+					Return(&ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []types.NetworkInterface{
+						{NetworkInterfaceId: aws.String("eni-nat"), InterfaceType: types.NetworkInterfaceTypeNatGateway},
+						{NetworkInterfaceId: aws.String("eni-nlb"), InterfaceType: types.NetworkInterfaceTypeNetworkLoadBalancer},
+					}}, nil).
+					Once()
+			}
+			if tt.filter != nil && tt.filter.MatchInstanceTags != nil {
+				mockClient.
+					On("DescribeTags", ctx, &ec2.DescribeTagsInput{
+						Filters: []types.Filter{
+							{Name: aws.String("resource-type"), Values: []string{"instance"}},
+							{Name: aws.String("resource-id"), Values: []string{"i-web", "i-batch"}},
+						},
+					}, mock.Anything).
+					Return(&ec2.DescribeTagsOutput{Tags: []types.TagDescription{
+						{ResourceId: aws.String("i-web"), Key: aws.String("role"), Value: aws.String("web")},
+						{ResourceId: aws.String("i-batch"), Key: aws.String("role"), Value: aws.String("batch")},
+					}}, nil).
+					Once()
+			}
+
+			resp, err := provider.Discover(ctx, &DiscoveryRequest{Regions: []string{"us-east-1"}, EIPFilter: tt.filter})
+			assert.NoError(t, err)
+
+			names := []string{}
+			for _, resource := range resp.Resources {
+				names = append(names, resource.Name)
+			}
+			assert.Equal(t, tt.expected, names)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
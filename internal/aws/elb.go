@@ -10,6 +10,7 @@ import (
 
 type ELBClient interface {
 	DescribeLoadBalancers(ctx context.Context, params *elasticloadbalancing.DescribeLoadBalancersInput, optFns ...func(*elasticloadbalancing.Options)) (*elasticloadbalancing.DescribeLoadBalancersOutput, error)
+	DescribeTags(ctx context.Context, params *elasticloadbalancing.DescribeTagsInput, optFns ...func(*elasticloadbalancing.Options)) (*elasticloadbalancing.DescribeTagsOutput, error)
 }
 
 type elbDiscoveryProvider struct {
@@ -30,6 +31,8 @@ func (p *elbDiscoveryProvider) Discover(ctx context.Context, request *DiscoveryR
 	resources := []DiscoveredResource{}
 
 	for _, region := range request.Regions {
+		regional := []DiscoveredResource{}
+
 		paginator := elasticloadbalancing.NewDescribeLoadBalancersPaginator(p.client, &elasticloadbalancing.DescribeLoadBalancersInput{})
 		for paginator.HasMorePages() {
 			output, err := paginator.NextPage(ctx, func(o *elasticloadbalancing.Options) {
@@ -40,16 +43,58 @@ func (p *elbDiscoveryProvider) Discover(ctx context.Context, request *DiscoveryR
 			}
 
 			for _, lb := range output.LoadBalancerDescriptions {
-				resources = append(resources, DiscoveredResource{
+				regional = append(regional, DiscoveredResource{
 					Type: "elasticloadbalancing/loadbalancer/classic",
-					Arn:  fmt.Sprintf("arn:aws:elasticloadbalancing:%s:%s:loadbalancer/%s", region, p.cache.GetAccountId(), *lb.LoadBalancerName),
+					Arn:  fmt.Sprintf("arn:%s:elasticloadbalancing:%s:%s:loadbalancer/%s", p.cache.GetPartition(), region, p.cache.GetAccountId(), *lb.LoadBalancerName),
 					Name: *lb.LoadBalancerName,
+					Tags: map[string]string{},
 				})
 			}
 		}
+
+		if request.WithTags {
+			if err := p.addTags(ctx, region, regional); err != nil {
+				return nil, err
+			}
+		}
+		resources = append(resources, regional...)
 	}
 
 	return &DiscoveryResponse{
 		Resources: resources,
 	}, nil
 }
+
+// addTags populates the tags of the load balancers discovered in a region
+func (p *elbDiscoveryProvider) addTags(ctx context.Context, region string, resources []DiscoveredResource) error {
+	byName := map[string]*DiscoveredResource{}
+	names := []string{}
+	for i := range resources {
+		byName[resources[i].Name] = &resources[i]
+		names = append(names, resources[i].Name)
+	}
+
+	// DescribeTags accepts at most 20 load balancers per call
+	for _, batch := range chunk(names, 20) {
+		output, err := p.client.DescribeTags(ctx, &elasticloadbalancing.DescribeTagsInput{
+			LoadBalancerNames: batch,
+		}, func(o *elasticloadbalancing.Options) {
+			o.Region = region
+		})
+		if err != nil {
+			return fmt.Errorf("error describing ELB Classic Load Balancer tags in region %s: %v", region, err)
+		}
+
+		for _, description := range output.TagDescriptions {
+			resource, ok := byName[aws.ToString(description.LoadBalancerName)]
+			if !ok {
+				continue
+			}
+			for _, tag := range description.Tags {
+				resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+		}
+	}
+
+	return nil
+}
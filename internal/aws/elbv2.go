@@ -11,6 +11,7 @@ import (
 
 type ELBV2Client interface {
 	DescribeLoadBalancers(ctx context.Context, params *elasticloadbalancingv2.DescribeLoadBalancersInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeLoadBalancersOutput, error)
+	DescribeTags(ctx context.Context, params *elasticloadbalancingv2.DescribeTagsInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTagsOutput, error)
 }
 
 type elbv2DiscoveryProvider struct {
@@ -29,6 +30,8 @@ func (p *elbv2DiscoveryProvider) Discover(ctx context.Context, request *Discover
 	resources := []DiscoveredResource{}
 
 	for _, region := range request.Regions {
+		regional := []DiscoveredResource{}
+
 		paginator := elasticloadbalancingv2.NewDescribeLoadBalancersPaginator(p.client, &elasticloadbalancingv2.DescribeLoadBalancersInput{})
 		for paginator.HasMorePages() {
 			output, err := paginator.NextPage(ctx, func(o *elasticloadbalancingv2.Options) {
@@ -40,17 +43,59 @@ func (p *elbv2DiscoveryProvider) Discover(ctx context.Context, request *Discover
 
 			for _, lb := range output.LoadBalancers {
 				if lb.Type == types.LoadBalancerTypeEnumApplication {
-					resources = append(resources, DiscoveredResource{
+					regional = append(regional, DiscoveredResource{
 						Type: "elasticloadbalancing/loadbalancer/app",
 						Arn:  *lb.LoadBalancerArn,
 						Name: *lb.LoadBalancerName,
+						Tags: map[string]string{},
 					})
 				}
 			}
 		}
+
+		if request.WithTags {
+			if err := addELBv2Tags(ctx, p.client, region, regional); err != nil {
+				return nil, err
+			}
+		}
+		resources = append(resources, regional...)
 	}
 
 	return &DiscoveryResponse{
 		Resources: resources,
 	}, nil
 }
+
+// addELBv2Tags populates the tags of the load balancers discovered in a region
+func addELBv2Tags(ctx context.Context, client ELBV2Client, region string, resources []DiscoveredResource) error {
+	byArn := map[string]*DiscoveredResource{}
+	arns := []string{}
+	for i := range resources {
+		byArn[resources[i].Arn] = &resources[i]
+		arns = append(arns, resources[i].Arn)
+	}
+
+	// DescribeTags accepts at most 20 resources per call
+	for _, batch := range chunk(arns, 20) {
+		output, err := client.DescribeTags(ctx, &elasticloadbalancingv2.DescribeTagsInput{
+			ResourceArns: batch,
+		}, func(o *elasticloadbalancingv2.Options) {
+			o.Region = region
+		})
+		if err != nil {
+			return fmt.Errorf("error describing ELBv2 load balancer tags in region %s: %v", region, err)
+		}
+
+		for _, description := range output.TagDescriptions {
+			resource, ok := byArn[aws.ToString(description.ResourceArn)]
+			if !ok {
+				continue
+			}
+			for _, tag := range description.Tags {
+				resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+		}
+	}
+
+	return nil
+}
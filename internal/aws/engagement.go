@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+	"github.com/aws/aws-sdk-go-v2/service/shield/types"
+)
+
+func (m *shieldManager) GetEmergencyContacts(ctx context.Context) ([]EmergencyContact, error) {
+	output, err := m.client.DescribeEmergencyContactSettings(ctx, &shield.DescribeEmergencyContactSettingsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("error describing emergency contact settings: %v", err)
+	}
+
+	var contacts []EmergencyContact
+	for _, contact := range output.EmergencyContactList {
+		contacts = append(contacts, EmergencyContact{
+			EmailAddress: aws.ToString(contact.EmailAddress),
+			PhoneNumber:  aws.ToString(contact.PhoneNumber),
+			ContactNotes: aws.ToString(contact.ContactNotes),
+		})
+	}
+
+	return contacts, nil
+}
+
+func (m *shieldManager) UpdateEmergencyContacts(ctx context.Context, contacts []EmergencyContact) error {
+	log := log.FromContext(ctx)
+
+	log.Info("Updating emergency contacts", "count", len(contacts))
+	_, err := m.client.UpdateEmergencyContactSettings(ctx, &shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: emergencyContactList(contacts),
+	})
+	if err != nil {
+		return fmt.Errorf("error updating emergency contact settings: %v", err)
+	}
+
+	return nil
+}
+
+// AssociateProactiveEngagement sets up proactive engagement with the given contacts, which replace the existing ones.
+// It is only needed once, after which proactive engagement is turned on and off with SetProactiveEngagement.
+func (m *shieldManager) AssociateProactiveEngagement(ctx context.Context, contacts []EmergencyContact) error {
+	log := log.FromContext(ctx)
+
+	log.Info("Associating proactive engagement details", "count", len(contacts))
+	_, err := m.client.AssociateProactiveEngagementDetails(ctx, &shield.AssociateProactiveEngagementDetailsInput{
+		EmergencyContactList: emergencyContactList(contacts),
+	})
+	if err != nil {
+		return fmt.Errorf("error associating proactive engagement details: %v", err)
+	}
+
+	return nil
+}
+
+func (m *shieldManager) SetProactiveEngagement(ctx context.Context, enabled bool) error {
+	log := log.FromContext(ctx)
+
+	log.Info("Setting proactive engagement", "enabled", enabled)
+	var err error
+	if enabled {
+		_, err = m.client.EnableProactiveEngagement(ctx, &shield.EnableProactiveEngagementInput{})
+	} else {
+		_, err = m.client.DisableProactiveEngagement(ctx, &shield.DisableProactiveEngagementInput{})
+	}
+	if err != nil {
+		return fmt.Errorf("error setting proactive engagement: %v", err)
+	}
+
+	return nil
+}
+
+func emergencyContactList(contacts []EmergencyContact) []types.EmergencyContact {
+	list := []types.EmergencyContact{}
+	for _, contact := range contacts {
+		item := types.EmergencyContact{EmailAddress: aws.String(contact.EmailAddress)}
+		if contact.PhoneNumber != "" {
+			item.PhoneNumber = aws.String(contact.PhoneNumber)
+		}
+		if contact.ContactNotes != "" {
+			item.ContactNotes = aws.String(contact.ContactNotes)
+		}
+		list = append(list, item)
+	}
+	return list
+}
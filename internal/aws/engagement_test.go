@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+	"github.com/aws/aws-sdk-go-v2/service/shield/types"
+)
+
+func TestAWSShieldManager_GetEmergencyContacts(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	mockClient.
+		On("DescribeEmergencyContactSettings", ctx, &shield.DescribeEmergencyContactSettingsInput{}, mock.Anything).
+		Return(&shield.DescribeEmergencyContactSettingsOutput{EmergencyContactList: []types.EmergencyContact{
+			{EmailAddress: aws.String("oncall@example.com"), PhoneNumber: aws.String("+15555550100")},
+			{EmailAddress: aws.String("security@example.com"), ContactNotes: aws.String("Business hours")},
+		}}, nil).
+		Once()
+
+	contacts, err := manager.GetEmergencyContacts(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []EmergencyContact{
+		{EmailAddress: "oncall@example.com", PhoneNumber: "+15555550100"},
+		{EmailAddress: "security@example.com", ContactNotes: "Business hours"},
+	}, contacts)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestAWSShieldManager_UpdateEmergencyContacts(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	// Removing all contacts sends an empty list rather than omitting it
+	mockClient.
+		On("UpdateEmergencyContactSettings", ctx, &shield.UpdateEmergencyContactSettingsInput{EmergencyContactList: []types.EmergencyContact{}}, mock.Anything).
+		Return(&shield.UpdateEmergencyContactSettingsOutput{}, nil).
+		Once()
+
+	err := manager.UpdateEmergencyContacts(ctx, nil)
+	assert.NoError(t, err)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestAWSShieldManager_SetProactiveEngagement(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	mockClient.
+		On("EnableProactiveEngagement", ctx, &shield.EnableProactiveEngagementInput{}, mock.Anything).
+		Return(&shield.EnableProactiveEngagementOutput{}, nil).
+		Once()
+	mockClient.
+		On("DisableProactiveEngagement", ctx, &shield.DisableProactiveEngagementInput{}, mock.Anything).
+		Return(&shield.DisableProactiveEngagementOutput{}, nil).
+		Once()
+
+	assert.NoError(t, manager.SetProactiveEngagement(ctx, true))
+	assert.NoError(t, manager.SetProactiveEngagement(ctx, false))
+
+	mockClient.AssertExpectations(t)
+}
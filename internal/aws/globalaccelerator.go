@@ -10,6 +10,7 @@ import (
 
 type GlobalAcceleratorClient interface {
 	ListAccelerators(ctx context.Context, params *globalaccelerator.ListAcceleratorsInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.ListAcceleratorsOutput, error)
+	ListTagsForResource(ctx context.Context, params *globalaccelerator.ListTagsForResourceInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.ListTagsForResourceOutput, error)
 }
 
 type globalAcceleratorDiscoveryProvider struct {
@@ -36,10 +37,24 @@ func (p *globalAcceleratorDiscoveryProvider) Discover(ctx context.Context, reque
 		}
 
 		for _, accelerator := range page.Accelerators {
+			tags := map[string]string{}
+			if request.WithTags {
+				output, err := p.client.ListTagsForResource(ctx, &globalaccelerator.ListTagsForResourceInput{
+					ResourceArn: accelerator.AcceleratorArn,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("error listing tags for Global Accelerator accelerator %s: %v", *accelerator.Name, err)
+				}
+				for _, tag := range output.Tags {
+					tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+				}
+			}
+
 			resources = append(resources, DiscoveredResource{
 				Type: "globalaccelerator/accelerator",
 				Arn:  *accelerator.AcceleratorArn,
 				Name: *accelerator.Name,
+				Tags: tags,
 			})
 		}
 	}
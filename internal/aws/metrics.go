@@ -0,0 +1,29 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/metrics"
+)
+
+// withShieldAPIMetrics records the latency and the errors of the calls of a Shield client per operation
+func withShieldAPIMetrics(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("ShieldAPIMetrics", func(
+		ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+	) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		operation := awsmiddleware.GetOperationName(ctx)
+		metrics.ShieldAPIRequestDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+		if err != nil {
+			metrics.ShieldAPIErrors.WithLabelValues(operation).Inc()
+		}
+
+		return out, metadata, err
+	}), middleware.After)
+}
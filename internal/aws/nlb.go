@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// ReasonNoElasticIP explains that a Network Load Balancer cannot be protected because it has no Elastic IP
+const ReasonNoElasticIP = "Network Load Balancer has no Elastic IP, Shield Advanced only protects Network Load Balancers through their Elastic IPs"
+
+// nlbDiscoveryProvider discovers the Elastic IPs of internet-facing Network Load Balancers, as Shield Advanced
+// protects Network Load Balancers only through the Elastic IPs of their subnet mappings
+type nlbDiscoveryProvider struct {
+	client ELBV2Client
+	cache  Cache
+}
+
+var _ DiscoveryProvider = &nlbDiscoveryProvider{}
+
+func NewNLBDiscoveryProvider(cfg aws.Config, cache Cache) DiscoveryProvider {
+	return &nlbDiscoveryProvider{
+		client: elasticloadbalancingv2.NewFromConfig(cfg),
+		cache:  cache,
+	}
+}
+
+// Discover returns an Elastic IP resource for each Elastic IP of the discovered Network Load Balancers, named
+// after its load balancer and with its tags, and reports the load balancers without Elastic IPs as unprotectable.
+// The Elastic IPs are only returned when the Elastic IP filter of the request selects those of Network Load Balancers.
+func (p *nlbDiscoveryProvider) Discover(ctx context.Context, request *DiscoveryRequest) (*DiscoveryResponse, error) {
+	response := &DiscoveryResponse{
+		Resources: []DiscoveredResource{},
+	}
+	selected := request.EIPFilter.selectsNetworkLoadBalancers()
+
+	for _, region := range request.Regions {
+		nlbs := []DiscoveredResource{}
+		allocations := map[string][]types.LoadBalancerAddress{}
+
+		paginator := elasticloadbalancingv2.NewDescribeLoadBalancersPaginator(p.client, &elasticloadbalancingv2.DescribeLoadBalancersInput{})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx, func(o *elasticloadbalancingv2.Options) {
+				o.Region = region
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error describing ELBv2 Network Load Balancers in region %s: %v", region, err)
+			}
+
+			for _, lb := range output.LoadBalancers {
+				// Internal load balancers have no public addresses to protect
+				if lb.Type != types.LoadBalancerTypeEnumNetwork || lb.Scheme != types.LoadBalancerSchemeEnumInternetFacing {
+					continue
+				}
+
+				arn := aws.ToString(lb.LoadBalancerArn)
+				nlbs = append(nlbs, DiscoveredResource{
+					Type: "elasticloadbalancing/loadbalancer/net",
+					Arn:  arn,
+					Name: aws.ToString(lb.LoadBalancerName),
+					Tags: map[string]string{},
+				})
+				for _, zone := range lb.AvailabilityZones {
+					for _, address := range zone.LoadBalancerAddresses {
+						if address.AllocationId != nil {
+							allocations[arn] = append(allocations[arn], address)
+						}
+					}
+				}
+			}
+		}
+
+		if request.WithTags {
+			if err := addELBv2Tags(ctx, p.client, region, nlbs); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, nlb := range nlbs {
+			if len(allocations[nlb.Arn]) == 0 {
+				response.Unprotectable = append(response.Unprotectable, UnprotectableResource{
+					DiscoveredResource: nlb,
+					Reason:             ReasonNoElasticIP,
+				})
+				continue
+			}
+			if !selected {
+				continue
+			}
+
+			for _, address := range allocations[nlb.Arn] {
+				response.Resources = append(response.Resources, DiscoveredResource{
+					Type: "elasticloadbalancing/loadbalancer/net",
+					Arn:  fmt.Sprintf("arn:%s:ec2:%s:%s:eip-allocation/%s", p.cache.GetPartition(), region, p.cache.GetAccountId(), *address.AllocationId),
+					Name: fmt.Sprintf("%s/%s", nlb.Name, aws.ToString(address.IpAddress)),
+					Tags: maps.Clone(nlb.Tags),
+				})
+			}
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+type mockELBV2Client struct {
+	mock.Mock
+}
+
+func (m *mockELBV2Client) DescribeLoadBalancers(ctx context.Context, input *elasticloadbalancingv2.DescribeLoadBalancersInput, opts ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeLoadBalancersOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*elasticloadbalancingv2.DescribeLoadBalancersOutput), args.Error(1)
+}
+
+func (m *mockELBV2Client) DescribeTags(ctx context.Context, input *elasticloadbalancingv2.DescribeTagsInput, opts ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTagsOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*elasticloadbalancingv2.DescribeTagsOutput), args.Error(1)
+}
+
+func TestNLBDiscoveryProvider_Discover(t *testing.T) {
+	mockClient := new(mockELBV2Client)
+	mockCache := new(mockAWSCache)
+	provider := &nlbDiscoveryProvider{client: mockClient, cache: mockCache}
+	ctx := context.Background()
+
+	withEIPs := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/with-eips/1111111111111111"
+	withoutEIPs := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/without-eips/2222222222222222"
+
+	mockCache.On("GetPartition").Return("aws")
+	mockCache.On("GetAccountId").Return("123456789012")
+	mockClient.
+		On("DescribeLoadBalancers", ctx, mock.Anything, mock.Anything).
+		Return(&elasticloadbalancingv2.DescribeLoadBalancersOutput{LoadBalancers: []types.LoadBalancer{
+			{
+				LoadBalancerArn:  aws.String(withEIPs),
+				LoadBalancerName: aws.String("with-eips"),
+				Type:             types.LoadBalancerTypeEnumNetwork,
+				Scheme:           types.LoadBalancerSchemeEnumInternetFacing,
+				AvailabilityZones: []types.AvailabilityZone{
+					{LoadBalancerAddresses: []types.LoadBalancerAddress{{AllocationId: aws.String("eipalloc-1"), IpAddress: aws.String("198.51.100.1")}}},
+					{LoadBalancerAddresses: []types.LoadBalancerAddress{{AllocationId: aws.String("eipalloc-2"), IpAddress: aws.String("198.51.100.2")}}},
+				},
+			},
+			{
+				LoadBalancerArn:   aws.String(withoutEIPs),
+				LoadBalancerName:  aws.String("without-eips"),
+				Type:              types.LoadBalancerTypeEnumNetwork,
+				Scheme:            types.LoadBalancerSchemeEnumInternetFacing,
+				AvailabilityZones: []types.AvailabilityZone{{LoadBalancerAddresses: []types.LoadBalancerAddress{{IpAddress: aws.String("198.51.100.3")}}}},
+			},
+			{
+				LoadBalancerArn:  aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/internal/3333333333333333"),
+				LoadBalancerName: aws.String("internal"),
+				Type:             types.LoadBalancerTypeEnumNetwork,
+				Scheme:           types.LoadBalancerSchemeEnumInternal,
+			},
+			{
+				LoadBalancerArn:  aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/alb/4444444444444444"),
+				LoadBalancerName: aws.String("alb"),
+				Type:             types.LoadBalancerTypeEnumApplication,
+				Scheme:           types.LoadBalancerSchemeEnumInternetFacing,
+			},
+		}}, nil).
+		Once()
+	mockClient.
+		On("DescribeTags", ctx, &elasticloadbalancingv2.DescribeTagsInput{ResourceArns: []string{withEIPs, withoutEIPs}}, mock.Anything).
+		Return(&elasticloadbalancingv2.DescribeTagsOutput{TagDescriptions: []types.TagDescription{
+			{ResourceArn: aws.String(withEIPs), Tags: []types.Tag{{Key: aws.String("env"), Value: aws.String("prod")}}},
+		}}, nil).
+		Once()
+
+	resp, err := provider.Discover(ctx, &DiscoveryRequest{Regions: []string{"us-east-1"}, WithTags: true})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []DiscoveredResource{
+		{
+			Type: "elasticloadbalancing/loadbalancer/net",
+			Arn:  "arn:aws:ec2:us-east-1:123456789012:eip-allocation/eipalloc-1",
+			Name: "with-eips/198.51.100.1",
+			Tags: map[string]string{"env": "prod"},
+		},
+		{
+			Type: "elasticloadbalancing/loadbalancer/net",
+			Arn:  "arn:aws:ec2:us-east-1:123456789012:eip-allocation/eipalloc-2",
+			Name: "with-eips/198.51.100.2",
+			Tags: map[string]string{"env": "prod"},
+		},
+	}, resp.Resources)
+
+	assert.Len(t, resp.Unprotectable, 1)
+	assert.Equal(t, withoutEIPs, resp.Unprotectable[0].Arn)
+	assert.Equal(t, ReasonNoElasticIP, resp.Unprotectable[0].Reason)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestNLBDiscoveryProvider_Discover_WithoutTags(t *testing.T) {
+	mockClient := new(mockELBV2Client)
+	mockCache := new(mockAWSCache)
+	provider := &nlbDiscoveryProvider{client: mockClient, cache: mockCache}
+	ctx := context.Background()
+
+	mockCache.On("GetPartition").Return("aws")
+	mockCache.On("GetAccountId").Return("123456789012")
+	mockClient.
+		On("DescribeLoadBalancers", ctx, mock.Anything, mock.Anything).
+		Return(&elasticloadbalancingv2.DescribeLoadBalancersOutput{LoadBalancers: []types.LoadBalancer{
+			{
+				LoadBalancerArn:  aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/web/1111111111111111"),
+				LoadBalancerName: aws.String("web"),
+				Type:             types.LoadBalancerTypeEnumNetwork,
+				Scheme:           types.LoadBalancerSchemeEnumInternetFacing,
+				AvailabilityZones: []types.AvailabilityZone{
+					{LoadBalancerAddresses: []types.LoadBalancerAddress{{AllocationId: aws.String("eipalloc-1"), IpAddress: aws.String("198.51.100.1")}}},
+				},
+			},
+		}}, nil).
+		Once()
+
+	resp, err := provider.Discover(ctx, &DiscoveryRequest{Regions: []string{"us-east-1"}})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Resources, 1)
+	assert.Empty(t, resp.Resources[0].Tags)
+
+	// DescribeTags is not expected, the mock fails when it is called
+	mockClient.AssertExpectations(t)
+}
+
+func TestNLBDiscoveryProvider_Discover_EIPFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   *EIPFilter
+		expected int
+	}{
+		{name: "Associated only", filter: &EIPFilter{AssociatedOnly: true}, expected: 1},
+		{name: "Network Load Balancers", filter: &EIPFilter{TargetTypes: []string{EIPTargetNetworkLoadBalancer}}, expected: 1},
+		{name: "NAT gateways", filter: &EIPFilter{TargetTypes: []string{EIPTargetNATGateway}}, expected: 0},
+		{
+			name: "Instance tags",
+			filter: &EIPFilter{MatchInstanceTags: func(tags map[string]string) (bool, error) {
+				return true, nil
+			}},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mockELBV2Client)
+			mockCache := new(mockAWSCache)
+			provider := &nlbDiscoveryProvider{client: mockClient, cache: mockCache}
+			ctx := context.Background()
+
+			mockCache.On("GetPartition").Return("aws").Maybe()
+			mockCache.On("GetAccountId").Return("123456789012").Maybe()
+			mockClient.
+				On("DescribeLoadBalancers", ctx, mock.Anything, mock.Anything).
+				Return(&elasticloadbalancingv2.DescribeLoadBalancersOutput{LoadBalancers: []types.LoadBalancer{
+					{
+						LoadBalancerArn:  aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/web/1111111111111111"),
+						LoadBalancerName: aws.String("web"),
+						Type:             types.LoadBalancerTypeEnumNetwork,
+						Scheme:           types.LoadBalancerSchemeEnumInternetFacing,
+						AvailabilityZones: []types.AvailabilityZone{
+							{LoadBalancerAddresses: []types.LoadBalancerAddress{{AllocationId: aws.String("eipalloc-1"), IpAddress: aws.String("198.51.100.1")}}},
+						},
+					},
+				}}, nil).
+				Once()
+
+			resp, err := provider.Discover(ctx, &DiscoveryRequest{Regions: []string{"us-east-1"}, EIPFilter: tt.filter})
+			assert.NoError(t, err)
+			assert.Len(t, resp.Resources, tt.expected)
+			assert.Empty(t, resp.Unprotectable)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
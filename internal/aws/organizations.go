@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+)
+
+type OrganizationsClient interface {
+	ListAccountsForParent(ctx context.Context, input *organizations.ListAccountsForParentInput, opts ...func(*organizations.Options)) (*organizations.ListAccountsForParentOutput, error)
+	ListOrganizationalUnitsForParent(ctx context.Context, input *organizations.ListOrganizationalUnitsForParentInput, opts ...func(*organizations.Options)) (*organizations.ListOrganizationalUnitsForParentOutput, error)
+}
+
+// ListOrganizationAccounts returns the sorted IDs of the active accounts of the organizational units,
+// including the accounts of nested organizational units
+func (m *accountManager) ListOrganizationAccounts(ctx context.Context, parentIds []string) ([]string, error) {
+	log := log.FromContext(ctx)
+
+	var accountIds []string
+	parents := slices.Clone(parentIds)
+	for len(parents) > 0 {
+		parent := parents[0]
+		parents = parents[1:]
+
+		accounts := organizations.NewListAccountsForParentPaginator(m.organizations, &organizations.ListAccountsForParentInput{
+			ParentId: aws.String(parent),
+		})
+		for accounts.HasMorePages() {
+			output, err := accounts.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list accounts of %s: %w", parent, err)
+			}
+
+			for _, account := range output.Accounts {
+				// Suspended accounts and accounts pending closure cannot be accessed
+				if account.Status == types.AccountStatusActive {
+					accountIds = append(accountIds, aws.ToString(account.Id))
+				}
+			}
+		}
+
+		units := organizations.NewListOrganizationalUnitsForParentPaginator(m.organizations, &organizations.ListOrganizationalUnitsForParentInput{
+			ParentId: aws.String(parent),
+		})
+		for units.HasMorePages() {
+			output, err := units.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list organizational units of %s: %w", parent, err)
+			}
+
+			for _, unit := range output.OrganizationalUnits {
+				parents = append(parents, aws.ToString(unit.Id))
+			}
+		}
+	}
+
+	slices.Sort(accountIds)
+	accountIds = slices.Compact(accountIds)
+
+	log.V(1).Info("Listed organization accounts", "parents", parentIds, "count", len(accountIds))
+
+	return accountIds, nil
+}
@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+	"github.com/aws/aws-sdk-go-v2/service/shield/types"
+)
+
+// Shield protected resource types by resource type. Keys must match the enum defined in the API
+var protectedResourceTypes = map[string]types.ProtectedResourceType{
+	"cloudfront/distribution":                   types.ProtectedResourceTypeCloudfrontDistribution,
+	"route53/hostedzone":                        types.ProtectedResourceTypeRoute53HostedZone,
+	"globalaccelerator/accelerator":             types.ProtectedResourceTypeGlobalAccelerator,
+	"ec2/eip":                                   types.ProtectedResourceTypeElasticIpAllocation,
+	"elasticloadbalancing/loadbalancer/app":     types.ProtectedResourceTypeApplicationLoadBalancer,
+	"elasticloadbalancing/loadbalancer/classic": types.ProtectedResourceTypeClassicLoadBalancer,
+}
+
+func (m *shieldManager) CreateOrUpdateProtectionGroup(ctx context.Context, group ProtectionGroup, owner Owner) (string, error) {
+	log := log.FromContext(ctx)
+
+	var resourceType types.ProtectedResourceType
+	if group.ResourceType != "" {
+		var ok bool
+		resourceType, ok = protectedResourceTypes[group.ResourceType]
+		if !ok {
+			return "", fmt.Errorf("unsupported protection group resource type: %s", group.ResourceType)
+		}
+	}
+
+	// Sort the members so that they can be compared with those of an existing group
+	members := slices.Clone(group.Members)
+	slices.Sort(members)
+
+	// Check if the protection group already exists
+	existing, err := m.client.DescribeProtectionGroup(ctx, &shield.DescribeProtectionGroupInput{
+		ProtectionGroupId: aws.String(group.Id),
+	})
+
+	// Protection group already exists, update it if needed
+	if err == nil {
+		protectionGroupArn := aws.ToString(existing.ProtectionGroup.ProtectionGroupArn)
+
+		tags, err := m.client.ListTagsForResource(ctx, &shield.ListTagsForResourceInput{
+			ResourceARN: aws.String(protectionGroupArn),
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to list tags for protection group: %w", err)
+		}
+
+		if !isOwnedBy(tags.Tags, owner) {
+			return "", fmt.Errorf("protection group %s already exists and is not owned by %s %s/%s", group.Id, owner.Kind, owner.Namespace, owner.Name)
+		}
+
+		existingMembers := slices.Clone(existing.ProtectionGroup.Members)
+		slices.Sort(existingMembers)
+
+		if string(existing.ProtectionGroup.Aggregation) == group.Aggregation &&
+			string(existing.ProtectionGroup.Pattern) == group.Pattern &&
+			existing.ProtectionGroup.ResourceType == resourceType &&
+			slices.Equal(existingMembers, members) {
+			log.V(1).Info("AWS Shield Advanced protection group is up to date", "protectionGroupId", group.Id)
+			return protectionGroupArn, nil
+		}
+
+		log.Info("Updating AWS Shield Advanced protection group", "protectionGroupId", group.Id)
+		_, err = m.client.UpdateProtectionGroup(ctx, &shield.UpdateProtectionGroupInput{
+			ProtectionGroupId: aws.String(group.Id),
+			Aggregation:       types.ProtectionGroupAggregation(group.Aggregation),
+			Pattern:           types.ProtectionGroupPattern(group.Pattern),
+			ResourceType:      resourceType,
+			Members:           members,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to update protection group: %w", err)
+		}
+
+		return protectionGroupArn, nil
+	}
+
+	var notFoundErr *types.ResourceNotFoundException
+	if !errors.As(err, &notFoundErr) {
+		// An error occurred while checking if the protection group exists
+		return "", err
+	}
+
+	// Protection group doesn't exist, create it
+	log.Info("Creating new AWS Shield Advanced protection group", "protectionGroupId", group.Id)
+	_, err = m.client.CreateProtectionGroup(ctx, &shield.CreateProtectionGroupInput{
+		ProtectionGroupId: aws.String(group.Id),
+		Aggregation:       types.ProtectionGroupAggregation(group.Aggregation),
+		Pattern:           types.ProtectionGroupPattern(group.Pattern),
+		ResourceType:      resourceType,
+		Members:           members,
+		Tags:              ownerTags(owner),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Get the protection group again so we can get its ARN
+	created, err := m.client.DescribeProtectionGroup(ctx, &shield.DescribeProtectionGroupInput{
+		ProtectionGroupId: aws.String(group.Id),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to describe protection group after creation: %w", err)
+	}
+
+	return aws.ToString(created.ProtectionGroup.ProtectionGroupArn), nil
+}
+
+func (m *shieldManager) DeleteProtectionGroup(ctx context.Context, protectionGroupId string, owner Owner) error {
+	log := log.FromContext(ctx)
+
+	existing, err := m.client.DescribeProtectionGroup(ctx, &shield.DescribeProtectionGroupInput{
+		ProtectionGroupId: aws.String(protectionGroupId),
+	})
+	if err != nil {
+		var notFoundErr *types.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			// Nothing to delete
+			return nil
+		}
+		return err
+	}
+
+	// Only delete protection groups owned by the owner
+	tags, err := m.client.ListTagsForResource(ctx, &shield.ListTagsForResourceInput{
+		ResourceARN: existing.ProtectionGroup.ProtectionGroupArn,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list tags for protection group: %w", err)
+	}
+
+	if !isOwnedBy(tags.Tags, owner) {
+		log.Info("Skipping deletion of AWS Shield Advanced protection group not owned by the controller", "protectionGroupId", protectionGroupId)
+		return nil
+	}
+
+	log.Info("Deleting AWS Shield Advanced protection group", "protectionGroupId", protectionGroupId)
+	_, err = m.client.DeleteProtectionGroup(ctx, &shield.DeleteProtectionGroupInput{
+		ProtectionGroupId: aws.String(protectionGroupId),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
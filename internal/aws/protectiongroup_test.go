@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+	"github.com/aws/aws-sdk-go-v2/service/shield/types"
+)
+
+func TestAWSShieldManager_CreateProtectionGroup(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	protectionGroupArn := "arn:aws:shield::123456789012:protection-group/my-group"
+
+	mockClient.
+		On("DescribeProtectionGroup", ctx, &shield.DescribeProtectionGroupInput{ProtectionGroupId: aws.String("my-group")}, mock.Anything).
+		Return(&shield.DescribeProtectionGroupOutput{}, &types.ResourceNotFoundException{}).
+		Once()
+	mockClient.
+		On("CreateProtectionGroup", ctx, &shield.CreateProtectionGroupInput{
+			ProtectionGroupId: aws.String("my-group"),
+			Aggregation:       types.ProtectionGroupAggregationSum,
+			Pattern:           types.ProtectionGroupPatternByResourceType,
+			ResourceType:      types.ProtectedResourceTypeApplicationLoadBalancer,
+			Members:           []string{},
+			Tags:              ownerTags(testOwner),
+		}, mock.Anything).
+		Return(&shield.CreateProtectionGroupOutput{}, nil).
+		Once()
+	mockClient.
+		On("DescribeProtectionGroup", ctx, &shield.DescribeProtectionGroupInput{ProtectionGroupId: aws.String("my-group")}, mock.Anything).
+		Return(&shield.DescribeProtectionGroupOutput{
+			ProtectionGroup: &types.ProtectionGroup{ProtectionGroupArn: aws.String(protectionGroupArn)},
+		}, nil).
+		Once()
+
+	arn, err := manager.CreateOrUpdateProtectionGroup(ctx, ProtectionGroup{
+		Id:           "my-group",
+		Aggregation:  "SUM",
+		Pattern:      "BY_RESOURCE_TYPE",
+		ResourceType: "elasticloadbalancing/loadbalancer/app",
+		Members:      []string{},
+	}, testOwner)
+	assert.NoError(t, err)
+	assert.Equal(t, protectionGroupArn, arn)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestAWSShieldManager_UpdateProtectionGroup(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	protectionGroupArn := "arn:aws:shield::123456789012:protection-group/my-group"
+	eip1 := "arn:aws:ec2:us-east-1:123456789012:eip-allocation/eipalloc-1"
+	eip2 := "arn:aws:ec2:us-east-1:123456789012:eip-allocation/eipalloc-2"
+
+	mockClient.
+		On("DescribeProtectionGroup", ctx, &shield.DescribeProtectionGroupInput{ProtectionGroupId: aws.String("my-group")}, mock.Anything).
+		Return(&shield.DescribeProtectionGroupOutput{
+			ProtectionGroup: &types.ProtectionGroup{
+				ProtectionGroupArn: aws.String(protectionGroupArn),
+				Aggregation:        types.ProtectionGroupAggregationSum,
+				Pattern:            types.ProtectionGroupPatternArbitrary,
+				Members:            []string{eip1},
+			},
+		}, nil).
+		Once()
+	mockClient.
+		On("ListTagsForResource", ctx, &shield.ListTagsForResourceInput{ResourceARN: aws.String(protectionGroupArn)}, mock.Anything).
+		Return(&shield.ListTagsForResourceOutput{Tags: ownerTags(testOwner)}, nil).
+		Once()
+	mockClient.
+		On("UpdateProtectionGroup", ctx, &shield.UpdateProtectionGroupInput{
+			ProtectionGroupId: aws.String("my-group"),
+			Aggregation:       types.ProtectionGroupAggregationSum,
+			Pattern:           types.ProtectionGroupPatternArbitrary,
+			Members:           []string{eip1, eip2},
+		}, mock.Anything).
+		Return(&shield.UpdateProtectionGroupOutput{}, nil).
+		Once()
+
+	arn, err := manager.CreateOrUpdateProtectionGroup(ctx, ProtectionGroup{
+		Id:          "my-group",
+		Aggregation: "SUM",
+		Pattern:     "ARBITRARY",
+		Members:     []string{eip2, eip1},
+	}, testOwner)
+	assert.NoError(t, err)
+	assert.Equal(t, protectionGroupArn, arn)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestAWSShieldManager_UpdateProtectionGroup_NotOwned(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	protectionGroupArn := "arn:aws:shield::123456789012:protection-group/my-group"
+
+	mockClient.
+		On("DescribeProtectionGroup", ctx, &shield.DescribeProtectionGroupInput{ProtectionGroupId: aws.String("my-group")}, mock.Anything).
+		Return(&shield.DescribeProtectionGroupOutput{
+			ProtectionGroup: &types.ProtectionGroup{ProtectionGroupArn: aws.String(protectionGroupArn)},
+		}, nil).
+		Once()
+	mockClient.
+		On("ListTagsForResource", ctx, &shield.ListTagsForResourceInput{ResourceARN: aws.String(protectionGroupArn)}, mock.Anything).
+		Return(&shield.ListTagsForResourceOutput{}, nil).
+		Once()
+
+	_, err := manager.CreateOrUpdateProtectionGroup(ctx, ProtectionGroup{
+		Id:          "my-group",
+		Aggregation: "MAX",
+		Pattern:     "ALL",
+	}, testOwner)
+	assert.Error(t, err)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestAWSShieldManager_DeleteProtectionGroup(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	protectionGroupArn := "arn:aws:shield::123456789012:protection-group/my-group"
+
+	mockClient.
+		On("DescribeProtectionGroup", ctx, &shield.DescribeProtectionGroupInput{ProtectionGroupId: aws.String("my-group")}, mock.Anything).
+		Return(&shield.DescribeProtectionGroupOutput{
+			ProtectionGroup: &types.ProtectionGroup{ProtectionGroupArn: aws.String(protectionGroupArn)},
+		}, nil).
+		Once()
+	mockClient.
+		On("ListTagsForResource", ctx, &shield.ListTagsForResourceInput{ResourceARN: aws.String(protectionGroupArn)}, mock.Anything).
+		Return(&shield.ListTagsForResourceOutput{Tags: ownerTags(testOwner)}, nil).
+		Once()
+	mockClient.
+		On("DeleteProtectionGroup", ctx, &shield.DeleteProtectionGroupInput{ProtectionGroupId: aws.String("my-group")}, mock.Anything).
+		Return(&shield.DeleteProtectionGroupOutput{}, nil).
+		Once()
+
+	err := manager.DeleteProtectionGroup(ctx, "my-group", testOwner)
+	assert.NoError(t, err)
+
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// resourceTypePrefixes maps the service and resource prefix of the ARNs of resources supported by Shield Advanced
+// to their resource type. Resource types must match the enum defined in the API.
+var resourceTypePrefixes = []struct {
+	service      string
+	prefix       string
+	resourceType string
+}{
+	{"cloudfront", "distribution/", "cloudfront/distribution"},
+	{"route53", "hostedzone/", "route53/hostedzone"},
+	{"globalaccelerator", "accelerator/", "globalaccelerator/accelerator"},
+	{"ec2", "eip-allocation/", "ec2/eip"},
+	{"elasticloadbalancing", "loadbalancer/app/", "elasticloadbalancing/loadbalancer/app"},
+}
+
+// regionalResourceTypes are the resource types discovered in each of the regions of a policy
+var regionalResourceTypes = map[string]bool{
+	"ec2/eip":                                   true,
+	"elasticloadbalancing/loadbalancer/app":     true,
+	"elasticloadbalancing/loadbalancer/classic": true,
+	"elasticloadbalancing/loadbalancer/net":     true,
+}
+
+// ResourceTypeFromArn returns the type of the resource of an ARN, or an error when the ARN is malformed
+// or Shield Advanced does not support the resource
+func ResourceTypeFromArn(resourceArn string) (string, error) {
+	parsed, err := arn.Parse(resourceArn)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s: %v", ErrInvalidResourceArn, resourceArn, err)
+	}
+
+	for _, p := range resourceTypePrefixes {
+		if parsed.Service == p.service && strings.HasPrefix(parsed.Resource, p.prefix) {
+			return p.resourceType, nil
+		}
+	}
+
+	// Classic Load Balancers have no type segment, unlike the other load balancers
+	if parsed.Service == "elasticloadbalancing" && strings.HasPrefix(parsed.Resource, "loadbalancer/") &&
+		strings.Count(parsed.Resource, "/") == 1 {
+		return "elasticloadbalancing/loadbalancer/classic", nil
+	}
+
+	return "", fmt.Errorf("%w: %s is not a resource supported by Shield Advanced", ErrInvalidResourceArn, resourceArn)
+}
+
+// IsRegionalResourceType returns whether resources of the type are discovered per region
+func IsRegionalResourceType(resourceType string) bool {
+	return regionalResourceTypes[resourceType]
+}
@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceTypeFromArn(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceArn  string
+		expectedType string
+		expectedErr  bool
+	}{
+		{
+			name:         "CloudFront distribution",
+			resourceArn:  "arn:aws:cloudfront::123456789012:distribution/EDFDVBD632BHDS5",
+			expectedType: "cloudfront/distribution",
+		},
+		{
+			name:         "Route 53 hosted zone",
+			resourceArn:  "arn:aws:route53:::hostedzone/Z1D633PJN98FT9",
+			expectedType: "route53/hostedzone",
+		},
+		{
+			name:         "Global Accelerator",
+			resourceArn:  "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh",
+			expectedType: "globalaccelerator/accelerator",
+		},
+		{
+			name:         "Elastic IP",
+			resourceArn:  "arn:aws-us-gov:ec2:us-gov-west-1:123456789012:eip-allocation/eipalloc-12345678",
+			expectedType: "ec2/eip",
+		},
+		{
+			name:         "Application Load Balancer",
+			resourceArn:  "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188",
+			expectedType: "elasticloadbalancing/loadbalancer/app",
+		},
+		{
+			name:         "Classic Load Balancer",
+			resourceArn:  "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/my-load-balancer",
+			expectedType: "elasticloadbalancing/loadbalancer/classic",
+		},
+		{
+			name:        "Network Load Balancer",
+			resourceArn: "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/my-load-balancer/50dc6c495c0c9188",
+			expectedErr: true,
+		},
+		{
+			name:        "Unsupported service",
+			resourceArn: "arn:aws:s3:::my-bucket",
+			expectedErr: true,
+		},
+		{
+			name:        "Malformed ARN",
+			resourceArn: "invalid-arn",
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resourceType, err := ResourceTypeFromArn(tt.resourceArn)
+			if tt.expectedErr {
+				assert.ErrorIs(t, err, ErrInvalidResourceArn)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedType, resourceType)
+			}
+		})
+	}
+}
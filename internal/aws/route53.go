@@ -6,22 +6,27 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
 )
 
 type Route53Client interface {
 	ListHostedZones(ctx context.Context, params *route53.ListHostedZonesInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesOutput, error)
+	ListTagsForResources(ctx context.Context, params *route53.ListTagsForResourcesInput, optFns ...func(*route53.Options)) (*route53.ListTagsForResourcesOutput, error)
 }
 
 type route53DiscoveryProvider struct {
 	client Route53Client
+	cache  Cache
 }
 
 var _ DiscoveryProvider = &route53DiscoveryProvider{}
 
-func NewRoute53DiscoveryProvider(cfg aws.Config) DiscoveryProvider {
+func NewRoute53DiscoveryProvider(cfg aws.Config, cache Cache) DiscoveryProvider {
 	return &route53DiscoveryProvider{
 		client: route53.NewFromConfig(cfg),
+		cache:  cache,
 	}
 }
 
@@ -39,13 +44,58 @@ func (p *route53DiscoveryProvider) Discover(ctx context.Context, request *Discov
 			id := strings.TrimPrefix(*zone.Id, "/")
 			resources = append(resources, DiscoveredResource{
 				Type: "route53/hostedzone",
-				Arn:  fmt.Sprintf("arn:aws:route53:::%s", id),
+				Arn:  fmt.Sprintf("arn:%s:route53:::%s", p.cache.GetPartition(), id),
 				Name: *zone.Name,
+				Tags: map[string]string{},
 			})
 		}
 	}
 
+	if request.WithTags {
+		if err := p.addTags(ctx, resources); err != nil {
+			return nil, err
+		}
+	}
+
 	return &DiscoveryResponse{
 		Resources: resources,
 	}, nil
 }
+
+// addTags populates the tags of the discovered hosted zones
+func (p *route53DiscoveryProvider) addTags(ctx context.Context, resources []DiscoveredResource) error {
+	byId := map[string]*DiscoveredResource{}
+	ids := []string{}
+	for i := range resources {
+		parsed, err := arn.Parse(resources[i].Arn)
+		if err != nil {
+			return err
+		}
+		id := strings.TrimPrefix(parsed.Resource, "hostedzone/")
+		byId[id] = &resources[i]
+		ids = append(ids, id)
+	}
+
+	// ListTagsForResources accepts at most 10 resources per call
+	for _, batch := range chunk(ids, 10) {
+		output, err := p.client.ListTagsForResources(ctx, &route53.ListTagsForResourcesInput{
+			ResourceType: types.TagResourceTypeHostedzone,
+			ResourceIds:  batch,
+		})
+		if err != nil {
+			return fmt.Errorf("error listing tags for Route53 hosted zones: %v", err)
+		}
+
+		for _, set := range output.ResourceTagSets {
+			resource, ok := byId[aws.ToString(set.ResourceId)]
+			if !ok {
+				continue
+			}
+			for _, tag := range set.Tags {
+				resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+		}
+	}
+
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -17,8 +19,20 @@ import (
 var (
 	OwnerTagKey   = "shield.aws.geode.io/owner"
 	OwnerTagValue = "aws-shield-advanced-controller"
+
+	// Tags identifying the Kubernetes object that owns a protection
+	OwnerKindTagKey      = "shield.aws.geode.io/owner-kind"
+	OwnerNamespaceTagKey = "shield.aws.geode.io/owner-namespace"
+	OwnerNameTagKey      = "shield.aws.geode.io/owner-name"
+	OwnerUIDTagKey       = "shield.aws.geode.io/owner-uid"
+
+	// OwnerOriginTagKey records whether a protection was created or adopted by the controller
+	OwnerOriginTagKey = "shield.aws.geode.io/origin"
 )
 
+// ErrInvalidResourceArn is returned when a resource ARN is malformed or in another partition than the AWS credentials
+var ErrInvalidResourceArn = errors.New("invalid resource ARN")
+
 type ShieldClient interface {
 	ListProtections(ctx context.Context, input *shield.ListProtectionsInput, opts ...func(*shield.Options)) (*shield.ListProtectionsOutput, error)
 	DescribeProtection(ctx context.Context, input *shield.DescribeProtectionInput, opts ...func(*shield.Options)) (*shield.DescribeProtectionOutput, error)
@@ -26,12 +40,54 @@ type ShieldClient interface {
 	DeleteProtection(ctx context.Context, input *shield.DeleteProtectionInput, opts ...func(*shield.Options)) (*shield.DeleteProtectionOutput, error)
 	ListTagsForResource(ctx context.Context, input *shield.ListTagsForResourceInput, opts ...func(*shield.Options)) (*shield.ListTagsForResourceOutput, error)
 	TagResource(ctx context.Context, input *shield.TagResourceInput, opts ...func(*shield.Options)) (*shield.TagResourceOutput, error)
+	UntagResource(ctx context.Context, input *shield.UntagResourceInput, opts ...func(*shield.Options)) (*shield.UntagResourceOutput, error)
+	EnableApplicationLayerAutomaticResponse(ctx context.Context, input *shield.EnableApplicationLayerAutomaticResponseInput, opts ...func(*shield.Options)) (*shield.EnableApplicationLayerAutomaticResponseOutput, error)
+	UpdateApplicationLayerAutomaticResponse(ctx context.Context, input *shield.UpdateApplicationLayerAutomaticResponseInput, opts ...func(*shield.Options)) (*shield.UpdateApplicationLayerAutomaticResponseOutput, error)
+	DisableApplicationLayerAutomaticResponse(ctx context.Context, input *shield.DisableApplicationLayerAutomaticResponseInput, opts ...func(*shield.Options)) (*shield.DisableApplicationLayerAutomaticResponseOutput, error)
+	AssociateHealthCheck(ctx context.Context, input *shield.AssociateHealthCheckInput, opts ...func(*shield.Options)) (*shield.AssociateHealthCheckOutput, error)
+	DisassociateHealthCheck(ctx context.Context, input *shield.DisassociateHealthCheckInput, opts ...func(*shield.Options)) (*shield.DisassociateHealthCheckOutput, error)
+	DescribeProtectionGroup(ctx context.Context, input *shield.DescribeProtectionGroupInput, opts ...func(*shield.Options)) (*shield.DescribeProtectionGroupOutput, error)
+	CreateProtectionGroup(ctx context.Context, input *shield.CreateProtectionGroupInput, opts ...func(*shield.Options)) (*shield.CreateProtectionGroupOutput, error)
+	UpdateProtectionGroup(ctx context.Context, input *shield.UpdateProtectionGroupInput, opts ...func(*shield.Options)) (*shield.UpdateProtectionGroupOutput, error)
+	DeleteProtectionGroup(ctx context.Context, input *shield.DeleteProtectionGroupInput, opts ...func(*shield.Options)) (*shield.DeleteProtectionGroupOutput, error)
+	GetSubscriptionState(ctx context.Context, input *shield.GetSubscriptionStateInput, opts ...func(*shield.Options)) (*shield.GetSubscriptionStateOutput, error)
+	DescribeSubscription(ctx context.Context, input *shield.DescribeSubscriptionInput, opts ...func(*shield.Options)) (*shield.DescribeSubscriptionOutput, error)
+	CreateSubscription(ctx context.Context, input *shield.CreateSubscriptionInput, opts ...func(*shield.Options)) (*shield.CreateSubscriptionOutput, error)
+	UpdateSubscription(ctx context.Context, input *shield.UpdateSubscriptionInput, opts ...func(*shield.Options)) (*shield.UpdateSubscriptionOutput, error)
+	DescribeEmergencyContactSettings(ctx context.Context, input *shield.DescribeEmergencyContactSettingsInput, opts ...func(*shield.Options)) (*shield.DescribeEmergencyContactSettingsOutput, error)
+	UpdateEmergencyContactSettings(ctx context.Context, input *shield.UpdateEmergencyContactSettingsInput, opts ...func(*shield.Options)) (*shield.UpdateEmergencyContactSettingsOutput, error)
+	AssociateProactiveEngagementDetails(ctx context.Context, input *shield.AssociateProactiveEngagementDetailsInput, opts ...func(*shield.Options)) (*shield.AssociateProactiveEngagementDetailsOutput, error)
+	EnableProactiveEngagement(ctx context.Context, input *shield.EnableProactiveEngagementInput, opts ...func(*shield.Options)) (*shield.EnableProactiveEngagementOutput, error)
+	DisableProactiveEngagement(ctx context.Context, input *shield.DisableProactiveEngagementInput, opts ...func(*shield.Options)) (*shield.DisableProactiveEngagementOutput, error)
+	DescribeDRTAccess(ctx context.Context, input *shield.DescribeDRTAccessInput, opts ...func(*shield.Options)) (*shield.DescribeDRTAccessOutput, error)
+	AssociateDRTRole(ctx context.Context, input *shield.AssociateDRTRoleInput, opts ...func(*shield.Options)) (*shield.AssociateDRTRoleOutput, error)
+	DisassociateDRTRole(ctx context.Context, input *shield.DisassociateDRTRoleInput, opts ...func(*shield.Options)) (*shield.DisassociateDRTRoleOutput, error)
+	AssociateDRTLogBucket(ctx context.Context, input *shield.AssociateDRTLogBucketInput, opts ...func(*shield.Options)) (*shield.AssociateDRTLogBucketOutput, error)
+	DisassociateDRTLogBucket(ctx context.Context, input *shield.DisassociateDRTLogBucketInput, opts ...func(*shield.Options)) (*shield.DisassociateDRTLogBucketOutput, error)
+	ListAttacks(ctx context.Context, input *shield.ListAttacksInput, opts ...func(*shield.Options)) (*shield.ListAttacksOutput, error)
+	DescribeAttack(ctx context.Context, input *shield.DescribeAttackInput, opts ...func(*shield.Options)) (*shield.DescribeAttackOutput, error)
 }
 
 type ShieldManager interface {
-	ListOwnedProtections(ctx context.Context) ([]types.Protection, error)
-	CreateOrUpdateProtection(ctx context.Context, name, resourceArn string) (string, error)
+	ListOwnedProtections(ctx context.Context, owner Owner) ([]types.Protection, error)
+	CreateOrUpdateProtection(ctx context.Context, request ProtectionRequest, owner Owner) (*ProtectionResult, error)
 	DeleteProtection(ctx context.Context, protectionArn string) error
+	ReleaseProtection(ctx context.Context, protectionArn string) error
+	CreateOrUpdateProtectionGroup(ctx context.Context, group ProtectionGroup, owner Owner) (string, error)
+	DeleteProtectionGroup(ctx context.Context, protectionGroupId string, owner Owner) error
+	GetSubscription(ctx context.Context) (*Subscription, error)
+	CreateSubscription(ctx context.Context) error
+	UpdateSubscription(ctx context.Context, autoRenew bool) error
+	GetEmergencyContacts(ctx context.Context) ([]EmergencyContact, error)
+	UpdateEmergencyContacts(ctx context.Context, contacts []EmergencyContact) error
+	AssociateProactiveEngagement(ctx context.Context, contacts []EmergencyContact) error
+	SetProactiveEngagement(ctx context.Context, enabled bool) error
+	GetDRTAccess(ctx context.Context) (*DRTAccess, error)
+	AssociateDRTRole(ctx context.Context, roleArn string) error
+	DisassociateDRTRole(ctx context.Context) error
+	AssociateDRTLogBucket(ctx context.Context, bucket string) error
+	DisassociateDRTLogBucket(ctx context.Context, bucket string) error
+	ListAttacks(ctx context.Context, since time.Time, known []Attack) ([]Attack, error)
 }
 
 type shieldManager struct {
@@ -43,12 +99,14 @@ var _ ShieldManager = &shieldManager{}
 
 func NewShieldManager(cfg aws.Config, cache Cache) ShieldManager {
 	return &shieldManager{
-		client: shield.NewFromConfig(cfg),
-		cache:  cache,
+		client: shield.NewFromConfig(cfg, func(o *shield.Options) {
+			o.APIOptions = append(o.APIOptions, withShieldAPIMetrics)
+		}),
+		cache: cache,
 	}
 }
 
-func (m *shieldManager) ListOwnedProtections(ctx context.Context) ([]types.Protection, error) {
+func (m *shieldManager) ListOwnedProtections(ctx context.Context, owner Owner) ([]types.Protection, error) {
 	log := log.FromContext(ctx)
 
 	var protections []types.Protection
@@ -62,7 +120,7 @@ func (m *shieldManager) ListOwnedProtections(ctx context.Context) ([]types.Prote
 		}
 
 		for _, protection := range output.Protections {
-			// Check if the protection is managed by the controller
+			// Check if the protection is managed by the controller on behalf of the owner
 			tags, err := m.client.ListTagsForResource(ctx, &shield.ListTagsForResourceInput{
 				ResourceARN: protection.ProtectionArn,
 			})
@@ -70,78 +128,301 @@ func (m *shieldManager) ListOwnedProtections(ctx context.Context) ([]types.Prote
 				return nil, fmt.Errorf("failed to list tags for protection: %w", err)
 			}
 
-			var isManaged bool
-			for _, tag := range tags.Tags {
-				if aws.ToString(tag.Key) == OwnerTagKey && aws.ToString(tag.Value) == OwnerTagValue {
-					isManaged = true
-					break
-				}
-			}
-
-			if isManaged {
+			if isOwnedBy(tags.Tags, owner) {
 				protections = append(protections, protection)
 			}
 		}
 	}
 
-	log.V(1).Info("Found existing AWS Shield Advanced protections", "count", len(protections))
+	log.V(1).Info("Found existing AWS Shield Advanced protections", "count", len(protections), "owner", owner)
 
 	return protections, nil
 }
 
-func (m *shieldManager) CreateOrUpdateProtection(ctx context.Context, name, resourceArn string) (string, error) {
+func (m *shieldManager) CreateOrUpdateProtection(ctx context.Context, request ProtectionRequest, owner Owner) (*ProtectionResult, error) {
 	log := log.FromContext(ctx)
 
+	name, resourceArn := request.Name, request.ResourceArn
+
+	err := m.validateResourceArn(resourceArn)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the resource protection already exists
 	existing, err := m.client.DescribeProtection(ctx, &shield.DescribeProtectionInput{
 		ResourceArn: aws.String(resourceArn),
 	})
 
-	// Protection already exists, update it if needed
+	var protection *types.Protection
+	var origin string
 	if err == nil {
+		// Protection already exists, update it if needed
 		log.V(1).Info("Syncing existing AWS Shield Advanced protection", "name", name, "resourceArn", resourceArn)
+		protection = existing.Protection
 
-		// Nothing to do here for now
+		origin, err = m.syncOwnership(ctx, protection, owner, request.AdoptionPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sync ownership: %w", err)
+		}
 
-		return *existing.Protection.ProtectionArn, nil
-	}
+		// The settings of a protection that is not managed on behalf of the owner are left untouched
+		if origin == OriginUnmanaged {
+			return &ProtectionResult{
+				ProtectionArn: aws.ToString(protection.ProtectionArn),
+				Origin:        origin,
+			}, nil
+		}
+	} else {
+		var notFoundErr *types.ResourceNotFoundException
+		if !errors.As(err, &notFoundErr) {
+			// An error occurred while checking if the protection exists
+			return nil, err
+		}
 
-	var notFoundErr *types.ResourceNotFoundException
-	if errors.As(err, &notFoundErr) {
-		// Protection doesn't exist, create it
+		// Protection doesn't exist, create it tagged with owner info so that it is never left without an owner
 		log.Info("Creating new AWS Shield Advanced protection", "name", name, "resourceArn", resourceArn)
-		protection, err := m.client.CreateProtection(ctx, &shield.CreateProtectionInput{
+		_, err := m.client.CreateProtection(ctx, &shield.CreateProtectionInput{
 			Name:        aws.String(name),
 			ResourceArn: aws.String(resourceArn),
+			Tags:        originTags(owner, OriginCreated),
+		})
+		if err != nil {
+			return nil, err
+		}
+		origin = OriginCreated
+
+		// Get the protection again so we can get its ARN
+		described, err := m.client.DescribeProtection(ctx, &shield.DescribeProtectionInput{
+			ResourceArn: aws.String(resourceArn),
 		})
 		if err != nil {
-			return "", err
+			return nil, fmt.Errorf("failed to describe protection after creation: %w", err)
 		}
+		protection = described.Protection
+	}
 
-		// Tag with owner info
+	response, err := m.syncApplicationLayerAutomaticResponse(ctx, protection, request.ApplicationLayerAutomaticResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync application layer automatic response: %w", err)
+	}
+
+	healthCheckArns, err := m.syncHealthChecks(ctx, protection, request.HealthCheckArns, request.PreviousHealthCheckArns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync health checks: %w", err)
+	}
+
+	return &ProtectionResult{
+		ProtectionArn:                     aws.ToString(protection.ProtectionArn),
+		ApplicationLayerAutomaticResponse: response,
+		HealthCheckArns:                   healthCheckArns,
+		Origin:                            origin,
+	}, nil
+}
+
+// syncOwnership adopts an existing protection on behalf of the owner when the adoption policy allows it,
+// and returns whether the protection was created or adopted by the owner, or is not managed on its behalf
+func (m *shieldManager) syncOwnership(ctx context.Context, protection *types.Protection, owner Owner, policy string) (string, error) {
+	log := log.FromContext(ctx)
+
+	tags, err := m.client.ListTagsForResource(ctx, &shield.ListTagsForResourceInput{
+		ResourceARN: protection.ProtectionArn,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list tags for protection: %w", err)
+	}
+
+	values := tagValues(tags.Tags)
+	if isOwnedBy(tags.Tags, owner) {
+		// Protections tagged before origins were recorded can only have been created by the controller
+		if values[OwnerOriginTagKey] == OriginAdopted {
+			return OriginAdopted, nil
+		}
+		return OriginCreated, nil
+	}
+
+	// Protections managed on behalf of another owner, or by a version of the controller that did not record
+	// owners, are only taken over when always adopting
+	managed := values[OwnerTagKey] == OwnerTagValue
+	adopt := policy == AdoptionPolicyAlways || (policy == AdoptionPolicyIfUnowned && !managed)
+	if !adopt {
+		return OriginUnmanaged, nil
+	}
+
+	// Protections created before owners were recorded can only have been created by the controller
+	if isLegacyOwned(values) {
+		log.Info("Migrating legacy ownership of AWS Shield Advanced protection", "protectionArn", aws.ToString(protection.ProtectionArn), "owner", owner)
 		_, err = m.client.TagResource(ctx, &shield.TagResourceInput{
-			ResourceARN: aws.String(m.protectionIdToArn(*protection.ProtectionId)),
-			Tags: []types.Tag{
-				{Key: aws.String(OwnerTagKey), Value: aws.String(OwnerTagValue)},
-			},
+			ResourceARN: protection.ProtectionArn,
+			Tags:        originTags(owner, OriginCreated),
 		})
 		if err != nil {
 			return "", fmt.Errorf("failed to tag protection: %w", err)
 		}
-	} else {
-		// An error occurred while checking if the protection exists
-		return "", err
+		return OriginCreated, nil
 	}
 
-	// Get the protection again so we can get its ARN
-	protection, err := m.client.DescribeProtection(ctx, &shield.DescribeProtectionInput{
-		ResourceArn: aws.String(resourceArn),
+	log.Info("Adopting existing AWS Shield Advanced protection", "protectionArn", aws.ToString(protection.ProtectionArn), "owner", owner)
+	_, err = m.client.TagResource(ctx, &shield.TagResourceInput{
+		ResourceARN: protection.ProtectionArn,
+		Tags:        originTags(owner, OriginAdopted),
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to describe protection after creation: %w", err)
+		return "", fmt.Errorf("failed to tag protection: %w", err)
+	}
+
+	return OriginAdopted, nil
+}
+
+// syncApplicationLayerAutomaticResponse converges the automatic application layer DDoS mitigation of the protection
+// to the desired setting and returns the resulting setting. It is left untouched when desired is nil.
+func (m *shieldManager) syncApplicationLayerAutomaticResponse(ctx context.Context, protection *types.Protection, desired *ApplicationLayerAutomaticResponse) (*ApplicationLayerAutomaticResponse, error) {
+	log := log.FromContext(ctx)
+
+	resourceArn := aws.ToString(protection.ResourceArn)
+	if !supportsApplicationLayerAutomaticResponse(resourceArn) {
+		if desired != nil && desired.Enabled {
+			log.V(1).Info("Automatic application layer DDoS mitigation is not supported for resource, skipping", "resourceArn", resourceArn)
+		}
+		return nil, nil
+	}
+
+	current := &ApplicationLayerAutomaticResponse{Enabled: false}
+	if config := protection.ApplicationLayerAutomaticResponseConfiguration; config != nil {
+		current.Enabled = config.Status == types.ApplicationLayerAutomaticResponseStatusEnabled
+		current.Action = responseActionName(config.Action)
+	}
+
+	if desired == nil {
+		return current, nil
+	}
+
+	// Normalize the desired action so that an unset action compares equal to the Count default
+	action := responseActionName(responseAction(desired.Action))
+
+	switch {
+	case !desired.Enabled && current.Enabled:
+		log.Info("Disabling automatic application layer DDoS mitigation", "resourceArn", resourceArn)
+		_, err := m.client.DisableApplicationLayerAutomaticResponse(ctx, &shield.DisableApplicationLayerAutomaticResponseInput{
+			ResourceArn: aws.String(resourceArn),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+	case desired.Enabled && !current.Enabled:
+		log.Info("Enabling automatic application layer DDoS mitigation", "resourceArn", resourceArn, "action", action)
+		_, err := m.client.EnableApplicationLayerAutomaticResponse(ctx, &shield.EnableApplicationLayerAutomaticResponseInput{
+			ResourceArn: aws.String(resourceArn),
+			Action:      responseAction(action),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+	case desired.Enabled && current.Action != action:
+		log.Info("Updating automatic application layer DDoS mitigation", "resourceArn", resourceArn, "action", action)
+		_, err := m.client.UpdateApplicationLayerAutomaticResponse(ctx, &shield.UpdateApplicationLayerAutomaticResponseInput{
+			ResourceArn: aws.String(resourceArn),
+			Action:      responseAction(action),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return current, nil
+	}
+
+	if !desired.Enabled {
+		return &ApplicationLayerAutomaticResponse{Enabled: false}, nil
+	}
+	return &ApplicationLayerAutomaticResponse{Enabled: true, Action: action}, nil
+}
+
+// syncHealthChecks associates the desired Route 53 health checks with the protection, disassociates any others,
+// and returns the ARNs of the associated health checks. When desired is nil, only the previously requested health
+// checks are disassociated and any other association is left untouched.
+func (m *shieldManager) syncHealthChecks(ctx context.Context, protection *types.Protection, desired, previous []string) ([]string, error) {
+	log := log.FromContext(ctx)
+
+	current := map[string]bool{}
+	for _, id := range protection.HealthCheckIds {
+		current[id] = true
+	}
+
+	if desired != nil {
+		wanted := map[string]string{}
+		for _, healthCheckArn := range desired {
+			id, err := healthCheckArnToId(healthCheckArn)
+			if err != nil {
+				return nil, err
+			}
+			wanted[id] = healthCheckArn
+		}
+
+		for id, healthCheckArn := range wanted {
+			if current[id] {
+				continue
+			}
+
+			log.Info("Associating health check with AWS Shield Advanced protection", "protectionArn", protection.ProtectionArn, "healthCheckArn", healthCheckArn)
+			_, err := m.client.AssociateHealthCheck(ctx, &shield.AssociateHealthCheckInput{
+				ProtectionId:   protection.Id,
+				HealthCheckArn: aws.String(healthCheckArn),
+			})
+			if err != nil {
+				return nil, err
+			}
+			current[id] = true
+		}
+
+		for id := range current {
+			if _, ok := wanted[id]; ok {
+				continue
+			}
+
+			if err := m.disassociateHealthCheck(ctx, protection, id); err != nil {
+				return nil, err
+			}
+			delete(current, id)
+		}
+	} else {
+		for _, healthCheckArn := range previous {
+			id, err := healthCheckArnToId(healthCheckArn)
+			if err != nil {
+				return nil, err
+			}
+			if !current[id] {
+				continue
+			}
+
+			if err := m.disassociateHealthCheck(ctx, protection, id); err != nil {
+				return nil, err
+			}
+			delete(current, id)
+		}
+	}
+
+	var healthCheckArns []string
+	for id := range current {
+		healthCheckArns = append(healthCheckArns, m.healthCheckIdToArn(id))
 	}
+	slices.Sort(healthCheckArns)
+
+	return healthCheckArns, nil
+}
 
-	return *protection.Protection.ProtectionArn, nil
+func (m *shieldManager) disassociateHealthCheck(ctx context.Context, protection *types.Protection, healthCheckId string) error {
+	log := log.FromContext(ctx)
+
+	healthCheckArn := m.healthCheckIdToArn(healthCheckId)
+	log.Info("Disassociating health check from AWS Shield Advanced protection", "protectionArn", protection.ProtectionArn, "healthCheckArn", healthCheckArn)
+	_, err := m.client.DisassociateHealthCheck(ctx, &shield.DisassociateHealthCheckInput{
+		ProtectionId:   protection.Id,
+		HealthCheckArn: aws.String(healthCheckArn),
+	})
+	return err
 }
 
 func (m *shieldManager) DeleteProtection(ctx context.Context, protectionArn string) error {
@@ -164,6 +445,37 @@ func (m *shieldManager) DeleteProtection(ctx context.Context, protectionArn stri
 	return nil
 }
 
+// ReleaseProtection removes the ownership tags from a protection so that it is left in place and no longer managed by the controller
+func (m *shieldManager) ReleaseProtection(ctx context.Context, protectionArn string) error {
+	log := log.FromContext(ctx)
+
+	log.Info("Releasing AWS Shield Advanced protection", "protectionArn", protectionArn)
+
+	_, err := m.client.UntagResource(ctx, &shield.UntagResourceInput{
+		ResourceARN: aws.String(protectionArn),
+		TagKeys:     ownerTagKeys(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to untag protection: %w", err)
+	}
+
+	return nil
+}
+
+// validateResourceArn checks that a resource ARN is well formed and in the partition of the AWS credentials
+func (m *shieldManager) validateResourceArn(resourceArn string) error {
+	parsed, err := arn.Parse(resourceArn)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidResourceArn, resourceArn, err)
+	}
+
+	if partition := m.cache.GetPartition(); parsed.Partition != partition {
+		return fmt.Errorf("%w: %s is not in partition %s", ErrInvalidResourceArn, resourceArn, partition)
+	}
+
+	return nil
+}
+
 func (m *shieldManager) protectionArnToId(protectionArn string) (string, error) {
 	parsed, err := arn.Parse(protectionArn)
 	if err != nil {
@@ -178,6 +490,108 @@ func (m *shieldManager) protectionArnToId(protectionArn string) (string, error)
 	return parts[1], nil
 }
 
-func (m *shieldManager) protectionIdToArn(protectionId string) string {
-	return fmt.Sprintf("arn:aws:shield::%s:protection/%s", m.cache.GetAccountId(), protectionId)
+func healthCheckArnToId(healthCheckArn string) (string, error) {
+	parsed, err := arn.Parse(healthCheckArn)
+	if err != nil {
+		return "", err
+	}
+
+	id, found := strings.CutPrefix(parsed.Resource, "healthcheck/")
+	if parsed.Service != "route53" || !found || id == "" {
+		return "", fmt.Errorf("invalid health check ARN: %s", healthCheckArn)
+	}
+
+	return id, nil
+}
+
+func (m *shieldManager) healthCheckIdToArn(healthCheckId string) string {
+	return fmt.Sprintf("arn:%s:route53:::healthcheck/%s", m.cache.GetPartition(), healthCheckId)
+}
+
+// ownerTags returns the tags recording that a Shield resource is managed by the controller on behalf of the owner
+func ownerTags(owner Owner) []types.Tag {
+	return []types.Tag{
+		{Key: aws.String(OwnerTagKey), Value: aws.String(OwnerTagValue)},
+		{Key: aws.String(OwnerKindTagKey), Value: aws.String(owner.Kind)},
+		{Key: aws.String(OwnerNamespaceTagKey), Value: aws.String(owner.Namespace)},
+		{Key: aws.String(OwnerNameTagKey), Value: aws.String(owner.Name)},
+		{Key: aws.String(OwnerUIDTagKey), Value: aws.String(owner.UID)},
+	}
+}
+
+// originTags returns the owner tags of a protection along with whether it was created or adopted by the controller
+func originTags(owner Owner, origin string) []types.Tag {
+	return append(ownerTags(owner), types.Tag{Key: aws.String(OwnerOriginTagKey), Value: aws.String(origin)})
+}
+
+// ownerTagKeys returns the keys of the tags recording that a Shield resource is managed by the controller
+func ownerTagKeys() []string {
+	return []string{OwnerTagKey, OwnerKindTagKey, OwnerNamespaceTagKey, OwnerNameTagKey, OwnerUIDTagKey, OwnerOriginTagKey}
+}
+
+// tagValues returns the values of the tags by key
+func tagValues(tags []types.Tag) map[string]string {
+	values := map[string]string{}
+	for _, tag := range tags {
+		values[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return values
+}
+
+// isOwnedBy reports whether the tags mark a Shield resource as managed by the controller on behalf of the owner.
+// The UID must match so that an object recreated with the same name does not silently inherit the resources
+// left behind by its predecessor, which it can only take over through its adoption policy.
+func isOwnedBy(tags []types.Tag, owner Owner) bool {
+	values := tagValues(tags)
+
+	return values[OwnerTagKey] == OwnerTagValue &&
+		values[OwnerKindTagKey] == owner.Kind &&
+		values[OwnerNamespaceTagKey] == owner.Namespace &&
+		values[OwnerNameTagKey] == owner.Name &&
+		values[OwnerUIDTagKey] == owner.UID
+}
+
+// isLegacyOwned reports whether the tag values mark a Shield resource as managed by a version of the controller
+// that did not record the object owning it
+func isLegacyOwned(values map[string]string) bool {
+	_, recorded := values[OwnerKindTagKey]
+	return values[OwnerTagKey] == OwnerTagValue && !recorded
+}
+
+// supportsApplicationLayerAutomaticResponse reports whether automatic application layer DDoS mitigation
+// can be enabled for the resource, which is only the case for CloudFront distributions and Application Load Balancers
+func supportsApplicationLayerAutomaticResponse(resourceArn string) bool {
+	parsed, err := arn.Parse(resourceArn)
+	if err != nil {
+		return false
+	}
+
+	switch parsed.Service {
+	case "cloudfront":
+		return strings.HasPrefix(parsed.Resource, "distribution/")
+	case "elasticloadbalancing":
+		return strings.HasPrefix(parsed.Resource, "loadbalancer/app/")
+	}
+	return false
+}
+
+// responseAction converts an action name (Block or Count) to a Shield response action, defaulting to Count
+func responseAction(action string) *types.ResponseAction {
+	if action == "Block" {
+		return &types.ResponseAction{Block: &types.BlockAction{}}
+	}
+	return &types.ResponseAction{Count: &types.CountAction{}}
+}
+
+// responseActionName converts a Shield response action to its action name
+func responseActionName(action *types.ResponseAction) string {
+	switch {
+	case action == nil:
+		return ""
+	case action.Block != nil:
+		return "Block"
+	case action.Count != nil:
+		return "Count"
+	}
+	return ""
 }
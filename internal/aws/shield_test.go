@@ -46,6 +46,136 @@ func (m *mockShieldClient) TagResource(ctx context.Context, input *shield.TagRes
 	return args.Get(0).(*shield.TagResourceOutput), args.Error(1)
 }
 
+func (m *mockShieldClient) UntagResource(ctx context.Context, input *shield.UntagResourceInput, opts ...func(*shield.Options)) (*shield.UntagResourceOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.UntagResourceOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) DescribeProtectionGroup(ctx context.Context, input *shield.DescribeProtectionGroupInput, opts ...func(*shield.Options)) (*shield.DescribeProtectionGroupOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.DescribeProtectionGroupOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) CreateProtectionGroup(ctx context.Context, input *shield.CreateProtectionGroupInput, opts ...func(*shield.Options)) (*shield.CreateProtectionGroupOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.CreateProtectionGroupOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) UpdateProtectionGroup(ctx context.Context, input *shield.UpdateProtectionGroupInput, opts ...func(*shield.Options)) (*shield.UpdateProtectionGroupOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.UpdateProtectionGroupOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) DeleteProtectionGroup(ctx context.Context, input *shield.DeleteProtectionGroupInput, opts ...func(*shield.Options)) (*shield.DeleteProtectionGroupOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.DeleteProtectionGroupOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) GetSubscriptionState(ctx context.Context, input *shield.GetSubscriptionStateInput, opts ...func(*shield.Options)) (*shield.GetSubscriptionStateOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.GetSubscriptionStateOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) CreateSubscription(ctx context.Context, input *shield.CreateSubscriptionInput, opts ...func(*shield.Options)) (*shield.CreateSubscriptionOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.CreateSubscriptionOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) UpdateSubscription(ctx context.Context, input *shield.UpdateSubscriptionInput, opts ...func(*shield.Options)) (*shield.UpdateSubscriptionOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.UpdateSubscriptionOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) DescribeEmergencyContactSettings(ctx context.Context, input *shield.DescribeEmergencyContactSettingsInput, opts ...func(*shield.Options)) (*shield.DescribeEmergencyContactSettingsOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.DescribeEmergencyContactSettingsOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) UpdateEmergencyContactSettings(ctx context.Context, input *shield.UpdateEmergencyContactSettingsInput, opts ...func(*shield.Options)) (*shield.UpdateEmergencyContactSettingsOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.UpdateEmergencyContactSettingsOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) AssociateProactiveEngagementDetails(ctx context.Context, input *shield.AssociateProactiveEngagementDetailsInput, opts ...func(*shield.Options)) (*shield.AssociateProactiveEngagementDetailsOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.AssociateProactiveEngagementDetailsOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) EnableProactiveEngagement(ctx context.Context, input *shield.EnableProactiveEngagementInput, opts ...func(*shield.Options)) (*shield.EnableProactiveEngagementOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.EnableProactiveEngagementOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) DisableProactiveEngagement(ctx context.Context, input *shield.DisableProactiveEngagementInput, opts ...func(*shield.Options)) (*shield.DisableProactiveEngagementOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.DisableProactiveEngagementOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) DescribeDRTAccess(ctx context.Context, input *shield.DescribeDRTAccessInput, opts ...func(*shield.Options)) (*shield.DescribeDRTAccessOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.DescribeDRTAccessOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) AssociateDRTRole(ctx context.Context, input *shield.AssociateDRTRoleInput, opts ...func(*shield.Options)) (*shield.AssociateDRTRoleOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.AssociateDRTRoleOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) DisassociateDRTRole(ctx context.Context, input *shield.DisassociateDRTRoleInput, opts ...func(*shield.Options)) (*shield.DisassociateDRTRoleOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.DisassociateDRTRoleOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) AssociateDRTLogBucket(ctx context.Context, input *shield.AssociateDRTLogBucketInput, opts ...func(*shield.Options)) (*shield.AssociateDRTLogBucketOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.AssociateDRTLogBucketOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) DisassociateDRTLogBucket(ctx context.Context, input *shield.DisassociateDRTLogBucketInput, opts ...func(*shield.Options)) (*shield.DisassociateDRTLogBucketOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.DisassociateDRTLogBucketOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) ListAttacks(ctx context.Context, input *shield.ListAttacksInput, opts ...func(*shield.Options)) (*shield.ListAttacksOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.ListAttacksOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) DescribeAttack(ctx context.Context, input *shield.DescribeAttackInput, opts ...func(*shield.Options)) (*shield.DescribeAttackOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.DescribeAttackOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) DescribeSubscription(ctx context.Context, input *shield.DescribeSubscriptionInput, opts ...func(*shield.Options)) (*shield.DescribeSubscriptionOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.DescribeSubscriptionOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) EnableApplicationLayerAutomaticResponse(ctx context.Context, input *shield.EnableApplicationLayerAutomaticResponseInput, opts ...func(*shield.Options)) (*shield.EnableApplicationLayerAutomaticResponseOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.EnableApplicationLayerAutomaticResponseOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) UpdateApplicationLayerAutomaticResponse(ctx context.Context, input *shield.UpdateApplicationLayerAutomaticResponseInput, opts ...func(*shield.Options)) (*shield.UpdateApplicationLayerAutomaticResponseOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.UpdateApplicationLayerAutomaticResponseOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) DisableApplicationLayerAutomaticResponse(ctx context.Context, input *shield.DisableApplicationLayerAutomaticResponseInput, opts ...func(*shield.Options)) (*shield.DisableApplicationLayerAutomaticResponseOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.DisableApplicationLayerAutomaticResponseOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) AssociateHealthCheck(ctx context.Context, input *shield.AssociateHealthCheckInput, opts ...func(*shield.Options)) (*shield.AssociateHealthCheckOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.AssociateHealthCheckOutput), args.Error(1)
+}
+
+func (m *mockShieldClient) DisassociateHealthCheck(ctx context.Context, input *shield.DisassociateHealthCheckInput, opts ...func(*shield.Options)) (*shield.DisassociateHealthCheckOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*shield.DisassociateHealthCheckOutput), args.Error(1)
+}
+
 type mockAWSCache struct {
 	mock.Mock
 }
@@ -60,6 +190,24 @@ func (m *mockAWSCache) GetAccountId() string {
 	return args.String(0)
 }
 
+func (m *mockAWSCache) GetPartition() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *mockAWSCache) GetRegions(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	regions, _ := args.Get(0).([]string)
+	return regions, args.Error(1)
+}
+
+var testOwner = Owner{
+	Kind:      "ProtectionPolicy",
+	Namespace: "default",
+	Name:      "my-policy",
+	UID:       "2d1c3b4a-0000-0000-0000-000000000000",
+}
+
 func TestAWSShieldManager_CreateProtection(t *testing.T) {
 	mockClient := new(mockShieldClient)
 	mockCache := new(mockAWSCache)
@@ -70,9 +218,8 @@ func TestAWSShieldManager_CreateProtection(t *testing.T) {
 	protectionArn := "arn:aws:shield::123456789012:protection/abc123"
 
 	mockCache.
-		On("GetAccountId").
-		Return("123456789012").
-		Once()
+		On("GetPartition").
+		Return("aws")
 
 	mockClient.
 		On("DescribeProtection", ctx, &shield.DescribeProtectionInput{ResourceArn: aws.String(resourceArn)}, mock.Anything).
@@ -82,35 +229,35 @@ func TestAWSShieldManager_CreateProtection(t *testing.T) {
 		On("CreateProtection", ctx, &shield.CreateProtectionInput{
 			Name:        aws.String("my-protection"),
 			ResourceArn: aws.String(resourceArn),
-		}, mock.Anything).
-		Return(&shield.CreateProtectionOutput{
-			ProtectionId: aws.String("abc123"),
-		}, nil).
-		Once()
-	mockClient.
-		On("TagResource", ctx, &shield.TagResourceInput{
-			ResourceARN: aws.String(protectionArn),
 			Tags: []types.Tag{
 				{Key: aws.String(OwnerTagKey), Value: aws.String(OwnerTagValue)},
+				{Key: aws.String(OwnerKindTagKey), Value: aws.String("ProtectionPolicy")},
+				{Key: aws.String(OwnerNamespaceTagKey), Value: aws.String("default")},
+				{Key: aws.String(OwnerNameTagKey), Value: aws.String("my-policy")},
+				{Key: aws.String(OwnerUIDTagKey), Value: aws.String("2d1c3b4a-0000-0000-0000-000000000000")},
+				{Key: aws.String(OwnerOriginTagKey), Value: aws.String(OriginCreated)},
 			},
 		}, mock.Anything).
-		Return(&shield.TagResourceOutput{}, nil).
+		Return(&shield.CreateProtectionOutput{
+			ProtectionId: aws.String("abc123"),
+		}, nil).
 		Once()
 	mockClient.
 		On("DescribeProtection", ctx, &shield.DescribeProtectionInput{ResourceArn: aws.String(resourceArn)}, mock.Anything).
 		Return(&shield.DescribeProtectionOutput{Protection: &types.Protection{ProtectionArn: aws.String(protectionArn)}}, nil).
 		Once()
 
-	arn, err := manager.CreateOrUpdateProtection(ctx, "my-protection", resourceArn)
+	result, err := manager.CreateOrUpdateProtection(ctx, ProtectionRequest{Name: "my-protection", ResourceArn: resourceArn}, testOwner)
 	assert.NoError(t, err)
-	assert.Equal(t, protectionArn, arn)
+	assert.Equal(t, protectionArn, result.ProtectionArn)
+	assert.Equal(t, OriginCreated, result.Origin)
 
 	mockClient.AssertExpectations(t)
 }
 
 func TestAWSShieldManager_UpdateProtection(t *testing.T) {
 	mockClient := new(mockShieldClient)
-	manager := &shieldManager{client: mockClient}
+	manager := &shieldManager{client: mockClient, cache: &cache{}}
 	ctx := context.Background()
 
 	resourceArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188"
@@ -120,15 +267,490 @@ func TestAWSShieldManager_UpdateProtection(t *testing.T) {
 		On("DescribeProtection", ctx, &shield.DescribeProtectionInput{ResourceArn: aws.String(resourceArn)}, mock.Anything).
 		Return(&shield.DescribeProtectionOutput{Protection: &types.Protection{ProtectionArn: aws.String(protectionArn)}}, nil).
 		Once()
+	mockClient.
+		On("ListTagsForResource", ctx, &shield.ListTagsForResourceInput{ResourceARN: aws.String(protectionArn)}, mock.Anything).
+		Return(&shield.ListTagsForResourceOutput{Tags: ownerTags(testOwner)}, nil).
+		Once()
+
+	result, err := manager.CreateOrUpdateProtection(ctx, ProtectionRequest{Name: "my-protection", ResourceArn: resourceArn}, testOwner)
+
+	assert.NoError(t, err)
+	assert.Equal(t, protectionArn, result.ProtectionArn)
+	assert.Equal(t, OriginCreated, result.Origin)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestAWSShieldManager_UpdateProtection_Unmanaged(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient, cache: &cache{}}
+	ctx := context.Background()
+
+	resourceArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188"
+	protectionArn := "arn:aws:shield::123456789012:protection/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+
+	mockClient.
+		On("DescribeProtection", ctx, &shield.DescribeProtectionInput{ResourceArn: aws.String(resourceArn)}, mock.Anything).
+		Return(&shield.DescribeProtectionOutput{Protection: &types.Protection{
+			ProtectionArn:  aws.String(protectionArn),
+			ResourceArn:    aws.String(resourceArn),
+			HealthCheckIds: []string{"abc"},
+		}}, nil).
+		Once()
+	mockClient.
+		On("ListTagsForResource", ctx, &shield.ListTagsForResourceInput{ResourceARN: aws.String(protectionArn)}, mock.Anything).
+		Return(&shield.ListTagsForResourceOutput{}, nil).
+		Once()
+
+	// Neither the automatic response nor the health checks of the unmanaged protection are changed
+	result, err := manager.CreateOrUpdateProtection(ctx, ProtectionRequest{
+		Name:                              "my-protection",
+		ResourceArn:                       resourceArn,
+		ApplicationLayerAutomaticResponse: &ApplicationLayerAutomaticResponse{Enabled: true, Action: "Block"},
+		HealthCheckArns:                   []string{},
+	}, testOwner)
+
+	assert.NoError(t, err)
+	assert.Equal(t, protectionArn, result.ProtectionArn)
+	assert.Equal(t, OriginUnmanaged, result.Origin)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestAWSShieldManager_SyncOwnership(t *testing.T) {
+	protectionArn := "arn:aws:shield::123456789012:protection/abc123"
+	otherOwner := Owner{Kind: "Protection", Namespace: "default", Name: "other"}
+	previousOwner := Owner{Kind: testOwner.Kind, Namespace: testOwner.Namespace, Name: testOwner.Name, UID: "9a8b7c6d-0000-0000-0000-000000000000"}
+	legacyTags := []types.Tag{{Key: aws.String(OwnerTagKey), Value: aws.String(OwnerTagValue)}}
+
+	tests := []struct {
+		name     string
+		policy   string
+		tags     []types.Tag
+		tagged   bool
+		expected string
+	}{
+		{
+			name:     "created by owner",
+			policy:   AdoptionPolicyNever,
+			tags:     originTags(testOwner, OriginCreated),
+			expected: OriginCreated,
+		},
+		{
+			name:     "adopted by owner",
+			policy:   AdoptionPolicyNever,
+			tags:     originTags(testOwner, OriginAdopted),
+			expected: OriginAdopted,
+		},
+		{
+			name:     "unowned and never adopted",
+			policy:   AdoptionPolicyNever,
+			expected: OriginUnmanaged,
+		},
+		{
+			name:     "unowned and adoption policy unset",
+			expected: OriginUnmanaged,
+		},
+		{
+			name:     "unowned and adopted if unowned",
+			policy:   AdoptionPolicyIfUnowned,
+			tagged:   true,
+			expected: OriginAdopted,
+		},
+		{
+			name:     "owned by another object and adopted if unowned",
+			policy:   AdoptionPolicyIfUnowned,
+			tags:     ownerTags(otherOwner),
+			expected: OriginUnmanaged,
+		},
+		{
+			name:     "owned by another object and always adopted",
+			policy:   AdoptionPolicyAlways,
+			tags:     ownerTags(otherOwner),
+			tagged:   true,
+			expected: OriginAdopted,
+		},
+		{
+			name:     "owned by a previous object with the same name and adopted if unowned",
+			policy:   AdoptionPolicyIfUnowned,
+			tags:     originTags(previousOwner, OriginCreated),
+			expected: OriginUnmanaged,
+		},
+		{
+			name:     "legacy controller tag never adopted",
+			policy:   AdoptionPolicyNever,
+			tags:     legacyTags,
+			expected: OriginUnmanaged,
+		},
+		{
+			name:     "legacy controller tag adopted if unowned",
+			policy:   AdoptionPolicyIfUnowned,
+			tags:     legacyTags,
+			expected: OriginUnmanaged,
+		},
+		{
+			name:     "legacy controller tag migrated to the owner when always adopted",
+			policy:   AdoptionPolicyAlways,
+			tags:     legacyTags,
+			tagged:   true,
+			expected: OriginCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mockShieldClient)
+			manager := &shieldManager{client: mockClient}
+			ctx := context.Background()
+
+			mockClient.
+				On("ListTagsForResource", ctx, &shield.ListTagsForResourceInput{ResourceARN: aws.String(protectionArn)}, mock.Anything).
+				Return(&shield.ListTagsForResourceOutput{Tags: tt.tags}, nil).
+				Once()
+			if tt.tagged {
+				mockClient.
+					On("TagResource", ctx, &shield.TagResourceInput{
+						ResourceARN: aws.String(protectionArn),
+						Tags:        originTags(testOwner, tt.expected),
+					}, mock.Anything).
+					Return(&shield.TagResourceOutput{}, nil).
+					Once()
+			}
+
+			origin, err := manager.syncOwnership(ctx, &types.Protection{ProtectionArn: aws.String(protectionArn)}, testOwner, tt.policy)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, origin)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAWSShieldManager_SyncApplicationLayerAutomaticResponse(t *testing.T) {
+	albArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188"
+	eipArn := "arn:aws:ec2:us-east-1:123456789012:eip-allocation/eipalloc-aabbccddee11223344"
+
+	enabled := func(action *types.ResponseAction) *types.ApplicationLayerAutomaticResponseConfiguration {
+		return &types.ApplicationLayerAutomaticResponseConfiguration{
+			Status: types.ApplicationLayerAutomaticResponseStatusEnabled,
+			Action: action,
+		}
+	}
+	block := &types.ResponseAction{Block: &types.BlockAction{}}
+	count := &types.ResponseAction{Count: &types.CountAction{}}
+
+	tests := []struct {
+		name        string
+		resourceArn string
+		current     *types.ApplicationLayerAutomaticResponseConfiguration
+		desired     *ApplicationLayerAutomaticResponse
+		setup       func(m *mockShieldClient)
+		expected    *ApplicationLayerAutomaticResponse
+	}{
+		{
+			name:        "Unmanaged reports current setting",
+			resourceArn: albArn,
+			current:     enabled(block),
+			desired:     nil,
+			expected:    &ApplicationLayerAutomaticResponse{Enabled: true, Action: "Block"},
+		},
+		{
+			name:        "Enable when disabled",
+			resourceArn: albArn,
+			desired:     &ApplicationLayerAutomaticResponse{Enabled: true, Action: "Block"},
+			setup: func(m *mockShieldClient) {
+				m.On("EnableApplicationLayerAutomaticResponse", mock.Anything, &shield.EnableApplicationLayerAutomaticResponseInput{
+					ResourceArn: aws.String(albArn),
+					Action:      block,
+				}, mock.Anything).Return(&shield.EnableApplicationLayerAutomaticResponseOutput{}, nil).Once()
+			},
+			expected: &ApplicationLayerAutomaticResponse{Enabled: true, Action: "Block"},
+		},
+		{
+			name:        "Update when action drifted",
+			resourceArn: albArn,
+			current:     enabled(block),
+			desired:     &ApplicationLayerAutomaticResponse{Enabled: true, Action: "Count"},
+			setup: func(m *mockShieldClient) {
+				m.On("UpdateApplicationLayerAutomaticResponse", mock.Anything, &shield.UpdateApplicationLayerAutomaticResponseInput{
+					ResourceArn: aws.String(albArn),
+					Action:      count,
+				}, mock.Anything).Return(&shield.UpdateApplicationLayerAutomaticResponseOutput{}, nil).Once()
+			},
+			expected: &ApplicationLayerAutomaticResponse{Enabled: true, Action: "Count"},
+		},
+		{
+			name:        "Unset action defaults to Count",
+			resourceArn: albArn,
+			current:     enabled(count),
+			desired:     &ApplicationLayerAutomaticResponse{Enabled: true},
+			expected:    &ApplicationLayerAutomaticResponse{Enabled: true, Action: "Count"},
+		},
+		{
+			name:        "Disable when enabled",
+			resourceArn: albArn,
+			current:     enabled(count),
+			desired:     &ApplicationLayerAutomaticResponse{Enabled: false},
+			setup: func(m *mockShieldClient) {
+				m.On("DisableApplicationLayerAutomaticResponse", mock.Anything, &shield.DisableApplicationLayerAutomaticResponseInput{
+					ResourceArn: aws.String(albArn),
+				}, mock.Anything).Return(&shield.DisableApplicationLayerAutomaticResponseOutput{}, nil).Once()
+			},
+			expected: &ApplicationLayerAutomaticResponse{Enabled: false},
+		},
+		{
+			name:        "Unsupported resource type is skipped",
+			resourceArn: eipArn,
+			desired:     &ApplicationLayerAutomaticResponse{Enabled: true, Action: "Block"},
+			expected:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mockShieldClient)
+			manager := &shieldManager{client: mockClient}
+			if tt.setup != nil {
+				tt.setup(mockClient)
+			}
+
+			response, err := manager.syncApplicationLayerAutomaticResponse(context.Background(), &types.Protection{
+				ResourceArn: aws.String(tt.resourceArn),
+				ApplicationLayerAutomaticResponseConfiguration: tt.current,
+			}, tt.desired)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, response)
+
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAWSShieldManager_ListOwnedProtections(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	ownedArn := "arn:aws:shield::123456789012:protection/owned"
+	otherPolicyArn := "arn:aws:shield::123456789012:protection/other-policy"
+	unmanagedArn := "arn:aws:shield::123456789012:protection/unmanaged"
+
+	mockClient.
+		On("ListProtections", ctx, mock.Anything, mock.Anything).
+		Return(&shield.ListProtectionsOutput{
+			Protections: []types.Protection{
+				{ProtectionArn: aws.String(ownedArn)},
+				{ProtectionArn: aws.String(otherPolicyArn)},
+				{ProtectionArn: aws.String(unmanagedArn)},
+			},
+		}, nil).
+		Once()
+	mockClient.
+		On("ListTagsForResource", ctx, &shield.ListTagsForResourceInput{ResourceARN: aws.String(ownedArn)}, mock.Anything).
+		Return(&shield.ListTagsForResourceOutput{Tags: ownerTags(testOwner)}, nil).
+		Once()
+	mockClient.
+		On("ListTagsForResource", ctx, &shield.ListTagsForResourceInput{ResourceARN: aws.String(otherPolicyArn)}, mock.Anything).
+		Return(&shield.ListTagsForResourceOutput{Tags: ownerTags(Owner{
+			Kind:      "ProtectionPolicy",
+			Namespace: "default",
+			Name:      "other-policy",
+			UID:       "5e6f7a8b-0000-0000-0000-000000000000",
+		})}, nil).
+		Once()
+	mockClient.
+		On("ListTagsForResource", ctx, &shield.ListTagsForResourceInput{ResourceARN: aws.String(unmanagedArn)}, mock.Anything).
+		Return(&shield.ListTagsForResourceOutput{}, nil).
+		Once()
+
+	protections, err := manager.ListOwnedProtections(ctx, testOwner)
+	assert.NoError(t, err)
+	assert.Len(t, protections, 1)
+	assert.Equal(t, ownedArn, aws.ToString(protections[0].ProtectionArn))
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestAWSShieldManager_IsOwnedBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     []types.Tag
+		expected bool
+	}{
+		{
+			name:     "Owned by the same object",
+			tags:     ownerTags(testOwner),
+			expected: true,
+		},
+		{
+			name: "Owned by a previous object with the same name",
+			tags: ownerTags(Owner{
+				Kind:      testOwner.Kind,
+				Namespace: testOwner.Namespace,
+				Name:      testOwner.Name,
+				UID:       "9a8b7c6d-0000-0000-0000-000000000000",
+			}),
+			expected: false,
+		},
+		{
+			name: "Owned by an object of another kind",
+			tags: ownerTags(Owner{
+				Kind:      "Protection",
+				Namespace: testOwner.Namespace,
+				Name:      testOwner.Name,
+			}),
+			expected: false,
+		},
+		{
+			name: "Owned by an object in another namespace",
+			tags: ownerTags(Owner{
+				Kind:      testOwner.Kind,
+				Namespace: "other",
+				Name:      testOwner.Name,
+			}),
+			expected: false,
+		},
+		{
+			name: "Legacy controller tag without owner",
+			tags: []types.Tag{
+				{Key: aws.String(OwnerTagKey), Value: aws.String(OwnerTagValue)},
+			},
+			expected: false,
+		},
+		{
+			name:     "No tags",
+			tags:     nil,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isOwnedBy(tt.tags, testOwner))
+		})
+	}
+}
+
+func TestAWSShieldManager_SyncHealthChecks(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient, cache: &cache{}}
+	ctx := context.Background()
+
+	protection := &types.Protection{
+		Id:             aws.String("abc123"),
+		ProtectionArn:  aws.String("arn:aws:shield::123456789012:protection/abc123"),
+		HealthCheckIds: []string{"kept", "removed"},
+	}
+
+	mockClient.
+		On("AssociateHealthCheck", ctx, &shield.AssociateHealthCheckInput{
+			ProtectionId:   aws.String("abc123"),
+			HealthCheckArn: aws.String("arn:aws:route53:::healthcheck/added"),
+		}, mock.Anything).
+		Return(&shield.AssociateHealthCheckOutput{}, nil).
+		Once()
+	mockClient.
+		On("DisassociateHealthCheck", ctx, &shield.DisassociateHealthCheckInput{
+			ProtectionId:   aws.String("abc123"),
+			HealthCheckArn: aws.String("arn:aws:route53:::healthcheck/removed"),
+		}, mock.Anything).
+		Return(&shield.DisassociateHealthCheckOutput{}, nil).
+		Once()
+
+	healthCheckArns, err := manager.syncHealthChecks(ctx, protection, []string{
+		"arn:aws:route53:::healthcheck/kept",
+		"arn:aws:route53:::healthcheck/added",
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"arn:aws:route53:::healthcheck/added",
+		"arn:aws:route53:::healthcheck/kept",
+	}, healthCheckArns)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestAWSShieldManager_SyncHealthChecks_Unmanaged(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient, cache: &cache{}}
 
-	arn, err := manager.CreateOrUpdateProtection(ctx, "my-protection", resourceArn)
+	healthCheckArns, err := manager.syncHealthChecks(context.Background(), &types.Protection{
+		HealthCheckIds: []string{"existing"},
+	}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"arn:aws:route53:::healthcheck/existing"}, healthCheckArns)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestAWSShieldManager_SyncHealthChecks_PreviouslyRequested(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient, cache: &cache{}}
+	ctx := context.Background()
+
+	protection := &types.Protection{
+		Id:             aws.String("abc123"),
+		ProtectionArn:  aws.String("arn:aws:shield::123456789012:protection/abc123"),
+		HealthCheckIds: []string{"manual", "requested"},
+	}
+
+	mockClient.
+		On("DisassociateHealthCheck", ctx, &shield.DisassociateHealthCheckInput{
+			ProtectionId:   aws.String("abc123"),
+			HealthCheckArn: aws.String("arn:aws:route53:::healthcheck/requested"),
+		}, mock.Anything).
+		Return(&shield.DisassociateHealthCheckOutput{}, nil).
+		Once()
 
+	// Health checks associated by hand are kept when health checks are no longer requested
+	healthCheckArns, err := manager.syncHealthChecks(ctx, protection, nil, []string{
+		"arn:aws:route53:::healthcheck/requested",
+		"arn:aws:route53:::healthcheck/already-removed",
+	})
 	assert.NoError(t, err)
-	assert.Equal(t, protectionArn, arn)
+	assert.Equal(t, []string{"arn:aws:route53:::healthcheck/manual"}, healthCheckArns)
 
 	mockClient.AssertExpectations(t)
 }
 
+func TestAWSShieldManager_HealthCheckArnToId(t *testing.T) {
+	tests := []struct {
+		name           string
+		healthCheckArn string
+		expectedId     string
+		expectError    bool
+	}{
+		{
+			name:           "Valid ARN",
+			healthCheckArn: "arn:aws:route53:::healthcheck/abcdef11-2222-3333-4444-555555fedcba",
+			expectedId:     "abcdef11-2222-3333-4444-555555fedcba",
+		},
+		{
+			name:           "Not a health check",
+			healthCheckArn: "arn:aws:route53:::hostedzone/Z1D633PJN98FT9",
+			expectError:    true,
+		},
+		{
+			name:           "Invalid ARN format",
+			healthCheckArn: "invalid-arn",
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := healthCheckArnToId(tt.healthCheckArn)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedId, id)
+			}
+		})
+	}
+}
+
 func TestAWSShieldManager_DeleteProtection(t *testing.T) {
 	mockClient := new(mockShieldClient)
 	manager := &shieldManager{client: mockClient}
@@ -147,6 +769,27 @@ func TestAWSShieldManager_DeleteProtection(t *testing.T) {
 
 	mockClient.AssertExpectations(t)
 }
+
+func TestAWSShieldManager_ReleaseProtection(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	protectionArn := "arn:aws:shield::123456789012:protection/abc123"
+
+	mockClient.
+		On("UntagResource", ctx, &shield.UntagResourceInput{
+			ResourceARN: aws.String(protectionArn),
+			TagKeys:     []string{OwnerTagKey, OwnerKindTagKey, OwnerNamespaceTagKey, OwnerNameTagKey, OwnerUIDTagKey, OwnerOriginTagKey},
+		}, mock.Anything).
+		Return(&shield.UntagResourceOutput{}, nil).
+		Once()
+
+	err := manager.ReleaseProtection(ctx, protectionArn)
+	assert.NoError(t, err)
+
+	mockClient.AssertExpectations(t)
+}
 func TestAWSShieldManager_ProtectionArnToId(t *testing.T) {
 	manager := &shieldManager{}
 
@@ -184,28 +827,38 @@ func TestAWSShieldManager_ProtectionArnToId(t *testing.T) {
 	}
 }
 
-func TestAWSShieldManager_ProtectionIdToArn(t *testing.T) {
-	accountID := "123456789012"
+func TestAWSShieldManager_HealthCheckIdToArn_Partition(t *testing.T) {
 	manager := &shieldManager{
-		cache: &cache{AccountId: accountID},
+		cache: &cache{AccountId: "123456789012", Partition: "aws-us-gov"},
 	}
 
+	assert.Equal(t, "arn:aws-us-gov:route53:::healthcheck/abc123", manager.healthCheckIdToArn("abc123"))
+}
+
+func TestAWSShieldManager_CreateProtection_InvalidResourceArn(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient, cache: &cache{Partition: "aws-cn"}}
+
 	tests := []struct {
-		name         string
-		protectionId string
-		expectedArn  string
+		name        string
+		resourceArn string
 	}{
 		{
-			name:         "Valid protection ID",
-			protectionId: "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
-			expectedArn:  "arn:aws:shield::123456789012:protection/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			name:        "Malformed ARN",
+			resourceArn: "invalid-arn",
+		},
+		{
+			name:        "ARN in another partition",
+			resourceArn: "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			arn := manager.protectionIdToArn(tt.protectionId)
-			assert.Equal(t, tt.expectedArn, arn)
+			_, err := manager.CreateOrUpdateProtection(context.Background(), ProtectionRequest{Name: "my-protection", ResourceArn: tt.resourceArn}, testOwner)
+			assert.ErrorIs(t, err, ErrInvalidResourceArn)
 		})
 	}
+
+	mockClient.AssertNotCalled(t, "DescribeProtection", mock.Anything, mock.Anything, mock.Anything)
 }
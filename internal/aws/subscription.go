@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+	"github.com/aws/aws-sdk-go-v2/service/shield/types"
+)
+
+func (m *shieldManager) GetSubscription(ctx context.Context) (*Subscription, error) {
+	state, err := m.client.GetSubscriptionState(ctx, &shield.GetSubscriptionStateInput{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting subscription state: %v", err)
+	}
+
+	if state.SubscriptionState != types.SubscriptionStateActive {
+		return &Subscription{Active: false}, nil
+	}
+
+	output, err := m.client.DescribeSubscription(ctx, &shield.DescribeSubscriptionInput{})
+	if err != nil {
+		return nil, fmt.Errorf("error describing subscription: %v", err)
+	}
+
+	subscription := &Subscription{
+		Active:         true,
+		EndTime:        output.Subscription.EndTime,
+		AutoRenew:      output.Subscription.AutoRenew == types.AutoRenewEnabled,
+		StartTime:      output.Subscription.StartTime,
+		TimeCommitment: time.Duration(output.Subscription.TimeCommitmentInSeconds) * time.Second,
+
+		ProactiveEngagementStatus: string(output.Subscription.ProactiveEngagementStatus),
+	}
+
+	if limits := output.Subscription.SubscriptionLimits; limits != nil {
+		if limits.ProtectionLimits != nil {
+			for _, limit := range limits.ProtectionLimits.ProtectedResourceTypeLimits {
+				subscription.ProtectionLimits = append(subscription.ProtectionLimits, Limit{
+					Type: aws.ToString(limit.Type),
+					Max:  limit.Max,
+				})
+			}
+		}
+		if limits.ProtectionGroupLimits != nil {
+			subscription.MaxProtectionGroups = limits.ProtectionGroupLimits.MaxProtectionGroups
+		}
+	}
+
+	return subscription, nil
+}
+
+func (m *shieldManager) CreateSubscription(ctx context.Context) error {
+	log := log.FromContext(ctx)
+
+	log.Info("Creating AWS Shield Advanced subscription")
+	_, err := m.client.CreateSubscription(ctx, &shield.CreateSubscriptionInput{})
+	if err != nil {
+		return fmt.Errorf("error creating subscription: %v", err)
+	}
+
+	return nil
+}
+
+func (m *shieldManager) UpdateSubscription(ctx context.Context, autoRenew bool) error {
+	log := log.FromContext(ctx)
+
+	input := &shield.UpdateSubscriptionInput{AutoRenew: types.AutoRenewDisabled}
+	if autoRenew {
+		input.AutoRenew = types.AutoRenewEnabled
+	}
+
+	log.Info("Updating AWS Shield Advanced subscription", "autoRenew", input.AutoRenew)
+	_, err := m.client.UpdateSubscription(ctx, input)
+	if err != nil {
+		return fmt.Errorf("error updating subscription: %v", err)
+	}
+
+	return nil
+}
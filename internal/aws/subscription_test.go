@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+	"github.com/aws/aws-sdk-go-v2/service/shield/types"
+)
+
+func TestAWSShieldManager_GetSubscription(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	startTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockClient.
+		On("GetSubscriptionState", ctx, &shield.GetSubscriptionStateInput{}, mock.Anything).
+		Return(&shield.GetSubscriptionStateOutput{SubscriptionState: types.SubscriptionStateActive}, nil).
+		Once()
+	mockClient.
+		On("DescribeSubscription", ctx, &shield.DescribeSubscriptionInput{}, mock.Anything).
+		Return(&shield.DescribeSubscriptionOutput{Subscription: &types.Subscription{
+			StartTime:               aws.Time(startTime),
+			EndTime:                 aws.Time(endTime),
+			AutoRenew:               types.AutoRenewDisabled,
+			TimeCommitmentInSeconds: 31536000,
+			SubscriptionLimits: &types.SubscriptionLimits{
+				ProtectionLimits: &types.ProtectionLimits{
+					ProtectedResourceTypeLimits: []types.Limit{{Type: aws.String("ELASTIC_IP_ADDRESS"), Max: 100}},
+				},
+				ProtectionGroupLimits: &types.ProtectionGroupLimits{MaxProtectionGroups: 20},
+			},
+		}}, nil).
+		Once()
+
+	subscription, err := manager.GetSubscription(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &Subscription{
+		Active:              true,
+		StartTime:           &startTime,
+		EndTime:             &endTime,
+		AutoRenew:           false,
+		TimeCommitment:      365 * 24 * time.Hour,
+		ProtectionLimits:    []Limit{{Type: "ELASTIC_IP_ADDRESS", Max: 100}},
+		MaxProtectionGroups: 20,
+	}, subscription)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestAWSShieldManager_GetSubscription_Inactive(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	mockClient.
+		On("GetSubscriptionState", ctx, &shield.GetSubscriptionStateInput{}, mock.Anything).
+		Return(&shield.GetSubscriptionStateOutput{SubscriptionState: types.SubscriptionStateInactive}, nil).
+		Once()
+
+	subscription, err := manager.GetSubscription(ctx)
+	assert.NoError(t, err)
+	assert.False(t, subscription.Active)
+
+	mockClient.AssertExpectations(t)
+	mockClient.AssertNotCalled(t, "DescribeSubscription", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAWSShieldManager_UpdateSubscription(t *testing.T) {
+	mockClient := new(mockShieldClient)
+	manager := &shieldManager{client: mockClient}
+	ctx := context.Background()
+
+	mockClient.
+		On("UpdateSubscription", ctx, &shield.UpdateSubscriptionInput{AutoRenew: types.AutoRenewEnabled}, mock.Anything).
+		Return(&shield.UpdateSubscriptionOutput{}, nil).
+		Once()
+
+	err := manager.UpdateSubscription(ctx, true)
+	assert.NoError(t, err)
+
+	mockClient.AssertExpectations(t)
+}
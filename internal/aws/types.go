@@ -1,6 +1,9 @@
 package aws
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type DiscoveryClient interface {
 	Discover(context.Context, *DiscoveryRequest) (*DiscoveryResponse, error)
@@ -12,15 +15,179 @@ type DiscoveryProvider interface {
 
 type DiscoveryRequest struct {
 	ResourceTypes []string
-	Regions       []string
+
+	// Regions are the regions of regional resources to discover, AllRegions expands to the regions enabled in the account
+	Regions []string
+
+	// ExcludeRegions are the regions never discovered, in particular when AllRegions is requested
+	ExcludeRegions []string
+
+	// EIPFilter selects the discovered Elastic IPs by their association, all are discovered when nil
+	EIPFilter *EIPFilter
+
+	// WithTags requests the tags of the discovered resources. Fetching them takes additional API calls,
+	// so the resources of most types are discovered without tags unless requested.
+	WithTags bool
 }
 
+// AllRegions requests the discovery of resources in all the regions enabled in the account
+const AllRegions = "*"
+
 type DiscoveryResponse struct {
 	Resources []DiscoveredResource
+
+	// Unprotectable are the discovered resources of the requested types that Shield Advanced cannot protect,
+	// such as Network Load Balancers without Elastic IPs
+	Unprotectable []UnprotectableResource
+
+	// Errors are the failures to discover some of the requested resource types. Resources is incomplete when set.
+	Errors []error
 }
 
 type DiscoveredResource struct {
 	Type string
 	Arn  string
 	Name string
+	Tags map[string]string
+}
+
+// UnprotectableResource is a discovered resource that Shield Advanced cannot protect
+type UnprotectableResource struct {
+	DiscoveredResource
+
+	// Reason explains why the resource cannot be protected
+	Reason string
+}
+
+// Owner identifies the Kubernetes object on whose behalf a Shield resource is managed
+type Owner struct {
+	Kind      string
+	Namespace string
+	Name      string
+	UID       string
+}
+
+// ProtectionGroup describes the desired configuration of a Shield Advanced protection group
+type ProtectionGroup struct {
+	Id           string
+	Aggregation  string
+	Pattern      string
+	ResourceType string
+	Members      []string
+}
+
+// ProtectionRequest describes the desired configuration of a Shield Advanced protection
+type ProtectionRequest struct {
+	Name        string
+	ResourceArn string
+
+	// ApplicationLayerAutomaticResponse is left unmanaged when nil
+	ApplicationLayerAutomaticResponse *ApplicationLayerAutomaticResponse
+
+	// HealthCheckArns are left unmanaged when nil, an empty list disassociates all health checks
+	HealthCheckArns []string
+
+	// PreviousHealthCheckArns are the health checks requested previously, which are disassociated
+	// when HealthCheckArns is nil
+	PreviousHealthCheckArns []string
+
+	// AdoptionPolicy determines whether an existing protection not owned by the owner is adopted, defaults to never
+	AdoptionPolicy string
+}
+
+const (
+	// AdoptionPolicyNever leaves existing protections unowned
+	AdoptionPolicyNever = "Never"
+
+	// AdoptionPolicyIfUnowned adopts existing protections that are not managed by the controller
+	AdoptionPolicyIfUnowned = "IfUnowned"
+
+	// AdoptionPolicyAlways adopts existing protections, including those managed on behalf of another owner
+	AdoptionPolicyAlways = "Always"
+)
+
+// ProtectionResult describes the observed configuration of a Shield Advanced protection
+type ProtectionResult struct {
+	ProtectionArn                     string
+	ApplicationLayerAutomaticResponse *ApplicationLayerAutomaticResponse
+	HealthCheckArns                   []string
+
+	// Origin is whether the protection was created or adopted by the owner, or is not managed on its behalf
+	Origin string
+}
+
+const (
+	// OriginCreated indicates that the protection was created on behalf of the owner
+	OriginCreated = "Created"
+
+	// OriginAdopted indicates that the protection existed beforehand and was adopted on behalf of the owner
+	OriginAdopted = "Adopted"
+
+	// OriginUnmanaged indicates that the protection exists but is not managed on behalf of the owner
+	OriginUnmanaged = "Unmanaged"
+)
+
+// ApplicationLayerAutomaticResponse describes automatic application layer DDoS mitigation for a protection
+type ApplicationLayerAutomaticResponse struct {
+	Enabled bool
+	Action  string
+}
+
+// Subscription describes the Shield Advanced subscription of an account
+type Subscription struct {
+	Active bool
+
+	// EndTime is when the current subscription period ends, if the subscription is active
+	EndTime *time.Time
+
+	// AutoRenew is whether the subscription is renewed at the end of the current period
+	AutoRenew bool
+
+	StartTime      *time.Time
+	TimeCommitment time.Duration
+
+	// ProtectionLimits are the maximum numbers of protected resources per resource type
+	ProtectionLimits    []Limit
+	MaxProtectionGroups int64
+
+	// ProactiveEngagementStatus is ENABLED, DISABLED or PENDING, or empty when proactive engagement was never set up
+	ProactiveEngagementStatus string
+}
+
+// Attack is a DDoS event detected by Shield Advanced on a protected resource
+type Attack struct {
+	Id          string
+	ResourceArn string
+	StartTime   *time.Time
+
+	// EndTime is unset while the attack is ongoing
+	EndTime *time.Time
+
+	// Vectors are the types of the attack, such as SYN_FLOOD or HTTP_REFLECTION
+	Vectors []string
+
+	// Mitigations are the mitigations applied by Shield Advanced
+	Mitigations []string
+}
+
+// DRTAccess is the access of the Shield Response Team (SRT) to the account
+type DRTAccess struct {
+	// RoleArn is the IAM role the SRT uses to access the account, or empty when none is associated
+	RoleArn string
+
+	// LogBuckets are the S3 buckets holding flow logs the SRT can access
+	LogBuckets []string
+}
+
+// EmergencyContact is a contact of the Shield Response Team (SRT) in the account
+type EmergencyContact struct {
+	EmailAddress string
+	PhoneNumber  string
+	ContactNotes string
+}
+
+// Limit is the maximum number of resources of a type
+type Limit struct {
+	Type string
+	Max  int64
 }
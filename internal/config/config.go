@@ -1,8 +1,32 @@
 package config
 
-import "time"
+import (
+	"time"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+)
 
 type Config struct {
-	DryRun               bool
-	PolicyResyncInterval time.Duration
+	DryRun                   bool
+	PolicyResyncInterval     time.Duration
+	ProtectionResyncInterval time.Duration
+
+	// DefaultDeletionPolicy applies to resources that do not set a deletion policy
+	DefaultDeletionPolicy shieldawsv1alpha1.DeletionPolicy
+
+	// SubscriptionCheckInterval is how often the Shield Advanced subscription is checked
+	SubscriptionCheckInterval time.Duration
+
+	// SubscriptionExpiryWarning is how long before its end a subscription that is not renewed automatically is reported as expiring
+	SubscriptionExpiryWarning time.Duration
+
+	// AttackPollInterval is how often attacks are polled, or zero to not poll them
+	AttackPollInterval time.Duration
+
+	// AttackLookback is how far back attacks are reported after they started, reported attacks are followed until they end
+	AttackLookback time.Duration
+
+	// SNSTopicArns are the ARNs of the SNS topics that notification channels may publish to, which may contain
+	// * wildcards. Channels cannot publish to any topic when empty.
+	SNSTopicArns []string
 }
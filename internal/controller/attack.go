@@ -0,0 +1,232 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/notify"
+)
+
+// AttackMonitor periodically polls the attacks detected by Shield Advanced in the account of the controller,
+// and reports them on the Protection and ProtectionPolicy resources protecting the attacked resources,
+// and to their notification channels. It is added to the manager as a runnable.
+type AttackMonitor struct {
+	client.Client
+
+	// APIReader reads the Secrets of the notification channels without caching every Secret of the cluster
+	APIReader client.Reader
+
+	Config        *config.Config
+	ShieldManager aws.ShieldManager
+	Recorder      record.EventRecorder
+
+	// HTTPClient sends the notifications of webhook and Slack channels, notify.DefaultHTTPClient when nil
+	HTTPClient *http.Client
+
+	// SNS publishes the notifications of SNS channels, which fail when it is nil
+	SNS notify.SNSClient
+}
+
+//+kubebuilder:rbac:groups=shield.aws.geode.io,resources=notificationchannels,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get
+
+// Start polls the attacks every poll interval until the context is done
+func (m *AttackMonitor) Start(ctx context.Context) error {
+	log := log.FromContext(ctx)
+
+	ticker := time.NewTicker(m.Config.AttackPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Poll(ctx); err != nil {
+			log.Error(err, "Failed to poll attacks")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection returns true so that only the leader records attacks
+func (m *AttackMonitor) NeedLeaderElection() bool {
+	return true
+}
+
+// Poll lists the attacks of the lookback window and the ongoing attacks, and records them on the resources
+// protecting the attacked resources
+func (m *AttackMonitor) Poll(ctx context.Context) error {
+	log := log.FromContext(ctx)
+
+	protections := &shieldawsv1alpha1.ProtectionList{}
+	if err := m.List(ctx, protections); err != nil {
+		return err
+	}
+	policies := &shieldawsv1alpha1.ProtectionPolicyList{}
+	if err := m.List(ctx, policies); err != nil {
+		return err
+	}
+
+	// The recorded attacks are not described again once ended, and are followed until they end when ongoing
+	var known []aws.Attack
+	seen := map[string]bool{}
+	addKnown := func(recorded []shieldawsv1alpha1.AttackStatus) {
+		for _, attack := range recorded {
+			if !seen[attack.AttackId] {
+				seen[attack.AttackId] = true
+				known = append(known, fromAttackStatus(attack))
+			}
+		}
+	}
+	for i := range protections.Items {
+		addKnown(protections.Items[i].Status.Attacks)
+	}
+	for i := range policies.Items {
+		addKnown(policies.Items[i].Status.Attacks)
+	}
+
+	attacks, err := m.ShieldManager.ListAttacks(ctx, time.Now().Add(-m.Config.AttackLookback), known)
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Polled attacks", "count", len(attacks))
+
+	byResource := map[string][]shieldawsv1alpha1.AttackStatus{}
+	for _, attack := range attacks {
+		byResource[attack.ResourceArn] = append(byResource[attack.ResourceArn], toAttackStatus(attack))
+	}
+
+	for i := range protections.Items {
+		protection := &protections.Items[i]
+		err := m.record(ctx, protection, protection.Spec.NotificationChannelRefs, &protection.Status.Attacks, byResource[protection.Spec.ResourceArn])
+		if err != nil {
+			log.Error(err, "Failed to record attacks", "protection", client.ObjectKeyFromObject(protection))
+		}
+	}
+
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+
+		// Attacks are only listed in the controller's own account
+		var policyAttacks []shieldawsv1alpha1.AttackStatus
+		for _, resourceArn := range localProtectedResources(policy) {
+			policyAttacks = append(policyAttacks, byResource[resourceArn]...)
+		}
+
+		err := m.record(ctx, policy, policy.Spec.NotificationChannelRefs, &policy.Status.Attacks, policyAttacks)
+		if err != nil {
+			log.Error(err, "Failed to record attacks", "protectionPolicy", client.ObjectKeyFromObject(policy))
+		}
+	}
+
+	return nil
+}
+
+// record records the attacks on the status of obj, whose current attacks are recorded in current, and records events
+// for the attacks that started or ended since they were last recorded. The start and end of each attack are notified
+// once to the channels referenced by obj when they are recorded, as the status records the channels left to notify.
+func (m *AttackMonitor) record(ctx context.Context, obj client.Object, refs []shieldawsv1alpha1.NotificationChannelReference, current *[]shieldawsv1alpha1.AttackStatus, attacks []shieldawsv1alpha1.AttackStatus) error {
+	sortAttacks(attacks)
+
+	for i := range attacks {
+		attack := &attacks[i]
+		j := slices.IndexFunc(*current, func(recorded shieldawsv1alpha1.AttackStatus) bool {
+			return recorded.AttackId == attack.AttackId
+		})
+		if j >= 0 {
+			attack.StartPendingChannels = (*current)[j].StartPendingChannels
+			attack.EndPendingChannels = (*current)[j].EndPendingChannels
+		}
+
+		switch {
+		case j < 0:
+			m.Recorder.Eventf(obj, corev1.EventTypeWarning, EventReasonAttackDetected, "Attack %s detected on resource %s with vectors %s",
+				attack.AttackId, attack.ResourceArn, strings.Join(attack.Vectors, ", "))
+			attack.StartPendingChannels = channelNames(refs)
+			if attack.EndTime != nil {
+				attack.EndPendingChannels = channelNames(refs)
+			}
+		case (*current)[j].EndTime == nil && attack.EndTime != nil:
+			m.Recorder.Eventf(obj, corev1.EventTypeNormal, EventReasonAttackEnded, "Attack %s on resource %s ended",
+				attack.AttackId, attack.ResourceArn)
+			attack.EndPendingChannels = channelNames(refs)
+		}
+	}
+
+	m.notifyAttacks(ctx, obj, refs, attacks)
+
+	if equality.Semantic.DeepEqual(*current, attacks) {
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	*current = attacks
+	return m.Status().Patch(ctx, obj, patch)
+}
+
+// sortAttacks sorts attacks by descending start time
+func sortAttacks(attacks []shieldawsv1alpha1.AttackStatus) {
+	slices.SortStableFunc(attacks, func(a, b shieldawsv1alpha1.AttackStatus) int {
+		switch {
+		case a.StartTime == nil || b.StartTime == nil:
+			return 0
+		case a.StartTime.Before(b.StartTime):
+			return 1
+		case b.StartTime.Before(a.StartTime):
+			return -1
+		}
+		return 0
+	})
+}
+
+// toAttackStatus converts an attack to its API representation, with times truncated to the precision of the API
+func toAttackStatus(attack aws.Attack) shieldawsv1alpha1.AttackStatus {
+	status := shieldawsv1alpha1.AttackStatus{
+		AttackId:    attack.Id,
+		ResourceArn: attack.ResourceArn,
+		Vectors:     attack.Vectors,
+		Mitigations: attack.Mitigations,
+	}
+	if attack.StartTime != nil {
+		startTime := metav1.NewTime(*attack.StartTime).Rfc3339Copy()
+		status.StartTime = &startTime
+	}
+	if attack.EndTime != nil {
+		endTime := metav1.NewTime(*attack.EndTime).Rfc3339Copy()
+		status.EndTime = &endTime
+	}
+	return status
+}
+
+// fromAttackStatus converts the API representation of an attack back to the attack
+func fromAttackStatus(status shieldawsv1alpha1.AttackStatus) aws.Attack {
+	attack := aws.Attack{
+		Id:          status.AttackId,
+		ResourceArn: status.ResourceArn,
+		Vectors:     status.Vectors,
+		Mitigations: status.Mitigations,
+	}
+	if status.StartTime != nil {
+		attack.StartTime = &status.StartTime.Time
+	}
+	if status.EndTime != nil {
+		attack.EndTime = &status.EndTime.Time
+	}
+	return attack
+}
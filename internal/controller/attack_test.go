@@ -0,0 +1,279 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/notify"
+)
+
+// fakeAttackManager returns a fixed list of attacks and records the attacks known by the last poll
+type fakeAttackManager struct {
+	aws.ShieldManager
+
+	attacks []aws.Attack
+	known   []aws.Attack
+}
+
+func (m *fakeAttackManager) ListAttacks(ctx context.Context, since time.Time, known []aws.Attack) ([]aws.Attack, error) {
+	m.known = known
+	return m.attacks, nil
+}
+
+func TestAttackMonitor_Poll(t *testing.T) {
+	ctx := context.Background()
+	albArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/1234567890abcdef"
+	eipArn := "arn:aws:ec2:us-east-1:123456789012:eip-allocation/eipalloc-0123456789abcdef0"
+	startTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	endTime := startTime.Add(30 * time.Minute)
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, shieldawsv1alpha1.AddToScheme(scheme))
+
+	protection := &shieldawsv1alpha1.Protection{
+		ObjectMeta: metav1.ObjectMeta{Name: "alb", Namespace: "default"},
+		Spec:       shieldawsv1alpha1.ProtectionSpec{ResourceArn: albArn},
+	}
+	policy := &shieldawsv1alpha1.ProtectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "eips", Namespace: "default"},
+		Status: shieldawsv1alpha1.ProtectionPolicyStatus{
+			Protections: []shieldawsv1alpha1.ProtectionStatus{
+				{ResourceArn: eipArn, State: shieldawsv1alpha1.ProtectionStateActive},
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(protection, policy).
+		WithStatusSubresource(protection, policy).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	manager := &fakeAttackManager{attacks: []aws.Attack{
+		{Id: "attack-1", ResourceArn: albArn, StartTime: &startTime, Vectors: []string{"SYN_FLOOD"}},
+		{Id: "attack-2", ResourceArn: eipArn, StartTime: &startTime, EndTime: &endTime, Vectors: []string{"UDP_REFLECTION"}},
+	}}
+	monitor := &AttackMonitor{
+		Client:        c,
+		Config:        &config.Config{AttackLookback: 24 * time.Hour},
+		ShieldManager: manager,
+		Recorder:      recorder,
+	}
+
+	assert.NoError(t, monitor.Poll(ctx))
+	assert.Len(t, recorder.Events, 2)
+
+	assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(protection), protection))
+	assert.Len(t, protection.Status.Attacks, 1)
+	assert.Equal(t, "attack-1", protection.Status.Attacks[0].AttackId)
+	assert.Nil(t, protection.Status.Attacks[0].EndTime)
+
+	assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(policy), policy))
+	assert.Len(t, policy.Status.Attacks, 1)
+	assert.Equal(t, "attack-2", policy.Status.Attacks[0].AttackId)
+
+	// Polling again records no event until the ongoing attack ends
+	assert.NoError(t, monitor.Poll(ctx))
+	assert.Len(t, recorder.Events, 2)
+	assert.ElementsMatch(t, []aws.Attack{
+		{Id: "attack-1", ResourceArn: albArn, StartTime: &startTime, Vectors: []string{"SYN_FLOOD"}},
+		{Id: "attack-2", ResourceArn: eipArn, StartTime: &startTime, EndTime: &endTime, Vectors: []string{"UDP_REFLECTION"}},
+	}, manager.known)
+
+	manager.attacks[0].EndTime = &endTime
+	assert.NoError(t, monitor.Poll(ctx))
+	assert.Len(t, recorder.Events, 3)
+
+	assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(protection), protection))
+	assert.NotNil(t, protection.Status.Attacks[0].EndTime)
+}
+
+func TestAttackMonitor_PollNotifies(t *testing.T) {
+	ctx := context.Background()
+	albArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/1234567890abcdef"
+	startTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	endTime := startTime.Add(30 * time.Minute)
+
+	// receive returns a server recording the notifications it receives, and responding with the status
+	receive := func(received *[]notify.Notification, status *int) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var notification notify.Notification
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&notification))
+			*received = append(*received, notification)
+			w.WriteHeader(*status)
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	var received, audited []notify.Notification
+	status, auditStatus := http.StatusInternalServerError, http.StatusOK
+	server := receive(&received, &status)
+	auditServer := receive(&audited, &auditStatus)
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, shieldawsv1alpha1.AddToScheme(scheme))
+	assert.NoError(t, corev1.AddToScheme(scheme))
+
+	protection := &shieldawsv1alpha1.Protection{
+		ObjectMeta: metav1.ObjectMeta{Name: "alb", Namespace: "default"},
+		Spec: shieldawsv1alpha1.ProtectionSpec{
+			ResourceArn:             albArn,
+			NotificationChannelRefs: []shieldawsv1alpha1.NotificationChannelReference{{Name: "oncall"}, {Name: "audit"}},
+		},
+	}
+	webhookChannel := func(name string) *shieldawsv1alpha1.NotificationChannel {
+		return &shieldawsv1alpha1.NotificationChannel{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: shieldawsv1alpha1.NotificationChannelSpec{
+				Webhook: &shieldawsv1alpha1.WebhookChannel{
+					URLSecretRef: shieldawsv1alpha1.SecretKeyReference{Name: name + "-webhook", Key: "url"},
+				},
+			},
+		}
+	}
+	secret := func(name, url string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-webhook", Namespace: "default"},
+			Data:       map[string][]byte{"url": []byte(url)},
+		}
+	}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(protection, webhookChannel("oncall"), secret("oncall", server.URL), webhookChannel("audit"), secret("audit", auditServer.URL)).
+		WithStatusSubresource(protection).
+		Build()
+	manager := &fakeAttackManager{attacks: []aws.Attack{
+		{Id: "attack-1", ResourceArn: albArn, StartTime: &startTime, Vectors: []string{"SYN_FLOOD"}},
+	}}
+	newMonitor := func() *AttackMonitor {
+		return &AttackMonitor{
+			Client:        c,
+			APIReader:     c,
+			Config:        &config.Config{AttackLookback: 24 * time.Hour},
+			ShieldManager: manager,
+			Recorder:      record.NewFakeRecorder(10),
+		}
+	}
+
+	// A failed notification is sent again on the next poll, only to the channel it failed on
+	assert.NoError(t, newMonitor().Poll(ctx))
+	assert.Len(t, received, 1)
+	assert.Len(t, audited, 1)
+	assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(protection), protection))
+	assert.Equal(t, []string{"oncall"}, protection.Status.Attacks[0].StartPendingChannels)
+
+	status = http.StatusOK
+	assert.NoError(t, newMonitor().Poll(ctx))
+	assert.Len(t, received, 2)
+	assert.Len(t, audited, 1)
+	assert.Equal(t, notify.EventAttackStarted, received[1].Event)
+	assert.Equal(t, "attack-1", received[1].AttackId)
+	assert.Equal(t, "Protection/default/alb", received[1].Source)
+
+	// A restarted monitor does not notify the start again
+	assert.NoError(t, newMonitor().Poll(ctx))
+	assert.Len(t, received, 2)
+	assert.Len(t, audited, 1)
+
+	manager.attacks[0].EndTime = &endTime
+	assert.NoError(t, newMonitor().Poll(ctx))
+	assert.NoError(t, newMonitor().Poll(ctx))
+	assert.Len(t, received, 3)
+	assert.Len(t, audited, 2)
+	assert.Equal(t, notify.EventAttackEnded, received[2].Event)
+	assert.Equal(t, notify.EventAttackEnded, audited[1].Event)
+
+	assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(protection), protection))
+	assert.Empty(t, protection.Status.Attacks[0].StartPendingChannels)
+	assert.Empty(t, protection.Status.Attacks[0].EndPendingChannels)
+}
+
+func TestAttackMonitor_PollNotifiesReferencedChannels(t *testing.T) {
+	ctx := context.Background()
+	albArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/1234567890abcdef"
+	startTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, shieldawsv1alpha1.AddToScheme(scheme))
+
+	protection := &shieldawsv1alpha1.Protection{
+		ObjectMeta: metav1.ObjectMeta{Name: "alb", Namespace: "default"},
+		Spec:       shieldawsv1alpha1.ProtectionSpec{ResourceArn: albArn},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(protection).
+		WithStatusSubresource(protection).
+		Build()
+	monitor := &AttackMonitor{
+		Client:    c,
+		APIReader: c,
+		Config:    &config.Config{AttackLookback: 24 * time.Hour},
+		ShieldManager: &fakeAttackManager{attacks: []aws.Attack{
+			{Id: "attack-1", ResourceArn: albArn, StartTime: &startTime},
+		}},
+		Recorder: record.NewFakeRecorder(10),
+	}
+
+	// Channels referenced after an attack was detected are not notified of it
+	assert.NoError(t, monitor.Poll(ctx))
+	assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(protection), protection))
+	protection.Spec.NotificationChannelRefs = []shieldawsv1alpha1.NotificationChannelReference{{Name: "missing"}}
+	assert.NoError(t, c.Update(ctx, protection))
+
+	assert.NoError(t, monitor.Poll(ctx))
+	assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(protection), protection))
+	assert.Empty(t, protection.Status.Attacks[0].StartPendingChannels)
+}
+
+func TestAttackMonitor_NotifierAllowsSNSTopics(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	assert.NoError(t, shieldawsv1alpha1.AddToScheme(scheme))
+
+	snsChannel := func(name, topicArn string) *shieldawsv1alpha1.NotificationChannel {
+		return &shieldawsv1alpha1.NotificationChannel{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: shieldawsv1alpha1.NotificationChannelSpec{
+				SNS: &shieldawsv1alpha1.SNSChannel{TopicArn: topicArn},
+			},
+		}
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			snsChannel("alerts", "arn:aws:sns:eu-west-1:123456789012:shield-alerts"),
+			snsChannel("other", "arn:aws:sns:eu-west-1:210987654321:other"),
+		).
+		Build()
+	monitor := &AttackMonitor{
+		Client: c,
+		Config: &config.Config{SNSTopicArns: []string{"arn:aws:sns:*:123456789012:shield-*"}},
+		SNS:    struct{ notify.SNSClient }{},
+	}
+
+	notifier, err := monitor.notifier(ctx, "default", "alerts")
+	assert.NoError(t, err)
+	assert.NotNil(t, notifier)
+
+	_, err = monitor.notifier(ctx, "default", "other")
+	assert.EqualError(t, err, "SNS topic arn:aws:sns:eu-west-1:210987654321:other is not allowed by the controller")
+}
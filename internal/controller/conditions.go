@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+)
+
+// setCondition sets a condition on the status, recording the generation it was observed for
+func setCondition(status *shieldawsv1alpha1.ReconcileStatus, generation int64, conditionType string, conditionStatus metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
+
+// markSynced records a successful reconciliation of the given generation
+func markSynced(status *shieldawsv1alpha1.ReconcileStatus, generation int64) {
+	now := metav1.Now()
+	status.ObservedGeneration = generation
+	status.LastSyncTime = &now
+	status.Message = ""
+
+	setCondition(status, generation, shieldawsv1alpha1.ConditionTypeSynced, metav1.ConditionTrue, shieldawsv1alpha1.ReasonSucceeded, "Resource is synced with AWS Shield Advanced")
+	setCondition(status, generation, shieldawsv1alpha1.ConditionTypeReady, metav1.ConditionTrue, shieldawsv1alpha1.ReasonSucceeded, "Resource is protected by AWS Shield Advanced")
+	setCondition(status, generation, shieldawsv1alpha1.ConditionTypeDegraded, metav1.ConditionFalse, shieldawsv1alpha1.ReasonSucceeded, "")
+}
+
+// markFailed records a failed reconciliation of the given generation
+func markFailed(status *shieldawsv1alpha1.ReconcileStatus, generation int64, reason string, err error) {
+	status.ObservedGeneration = generation
+	status.Message = err.Error()
+
+	setCondition(status, generation, shieldawsv1alpha1.ConditionTypeSynced, metav1.ConditionFalse, reason, err.Error())
+	setCondition(status, generation, shieldawsv1alpha1.ConditionTypeReady, metav1.ConditionFalse, reason, err.Error())
+}
+
+// markDegraded records a reconciliation of the given generation that only partially succeeded
+func markDegraded(status *shieldawsv1alpha1.ReconcileStatus, generation int64, reason, message string) {
+	status.ObservedGeneration = generation
+	status.Message = message
+
+	setCondition(status, generation, shieldawsv1alpha1.ConditionTypeSynced, metav1.ConditionFalse, reason, message)
+	setCondition(status, generation, shieldawsv1alpha1.ConditionTypeReady, metav1.ConditionFalse, reason, message)
+	setCondition(status, generation, shieldawsv1alpha1.ConditionTypeDegraded, metav1.ConditionTrue, reason, message)
+}
+
+// markDryRun records that the given generation was not applied because the controller runs in dry-run mode
+func markDryRun(status *shieldawsv1alpha1.ReconcileStatus, generation int64) {
+	message := "Dry-run mode enabled, changes are not applied to AWS Shield Advanced"
+	status.ObservedGeneration = generation
+	status.Message = ""
+
+	setCondition(status, generation, shieldawsv1alpha1.ConditionTypeSynced, metav1.ConditionFalse, shieldawsv1alpha1.ReasonDryRun, message)
+	setCondition(status, generation, shieldawsv1alpha1.ConditionTypeReady, metav1.ConditionFalse, shieldawsv1alpha1.ReasonDryRun, message)
+}
+
+// updateStatus persists the status of obj unless the reconciliation did not change it since original,
+// and returns reconcileErr, or the status update error if the reconciliation itself succeeded
+func updateStatus(ctx context.Context, c client.Client, original, obj client.Object, reconcileErr error) error {
+	log := log.FromContext(ctx)
+
+	if !statusChanged(original, obj) {
+		log.V(1).Info("Status is unchanged, skipping update")
+		return reconcileErr
+	}
+
+	err := c.Status().Update(ctx, obj)
+	if err != nil {
+		log.Error(err, "Failed to update status")
+		if reconcileErr == nil {
+			return err
+		}
+	}
+
+	return reconcileErr
+}
+
+// statusChanged reports whether the status of obj differs from that of original, including its last sync time, so
+// that every sync is recorded. Status writes do not change the generation, so they do not trigger reconciliations.
+func statusChanged(original, obj client.Object) bool {
+	before, err := runtime.DefaultUnstructuredConverter.ToUnstructured(original)
+	if err != nil {
+		return true
+	}
+	after, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return true
+	}
+
+	return !equality.Semantic.DeepEqual(before["status"], after["status"])
+}
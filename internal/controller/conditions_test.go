@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+)
+
+func TestStatusChanged(t *testing.T) {
+	original := &shieldawsv1alpha1.Protection{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+	markSynced(&original.Status.ReconcileStatus, 1)
+
+	tests := []struct {
+		name     string
+		update   func(protection *shieldawsv1alpha1.Protection)
+		expected bool
+	}{
+		{
+			name:     "Unchanged",
+			update:   func(protection *shieldawsv1alpha1.Protection) {},
+			expected: false,
+		},
+		{
+			name: "Synced again",
+			update: func(protection *shieldawsv1alpha1.Protection) {
+				synced := metav1.NewTime(time.Now().Add(time.Hour))
+				markSynced(&protection.Status.ReconcileStatus, 1)
+				protection.Status.LastSyncTime = &synced
+			},
+			expected: true,
+		},
+		{
+			name: "Synced a new generation",
+			update: func(protection *shieldawsv1alpha1.Protection) {
+				markSynced(&protection.Status.ReconcileStatus, 2)
+			},
+			expected: true,
+		},
+		{
+			name: "Failed",
+			update: func(protection *shieldawsv1alpha1.Protection) {
+				markFailed(&protection.Status.ReconcileStatus, 1, shieldawsv1alpha1.ReasonShieldAPIError, errors.New("throttled"))
+			},
+			expected: true,
+		},
+		{
+			name: "Health checks changed",
+			update: func(protection *shieldawsv1alpha1.Protection) {
+				protection.Status.HealthCheckArns = []string{"arn:aws:route53:::healthcheck/abc"}
+			},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			protection := original.DeepCopy()
+			tt.update(protection)
+			assert.Equal(t, tt.expected, statusChanged(original, protection))
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package controller
+
+import (
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+)
+
+// toAWSApplicationLayerAutomaticResponse converts the API setting to its AWS representation
+func toAWSApplicationLayerAutomaticResponse(in *shieldawsv1alpha1.ApplicationLayerAutomaticResponse) *aws.ApplicationLayerAutomaticResponse {
+	if in == nil {
+		return nil
+	}
+	return &aws.ApplicationLayerAutomaticResponse{
+		Enabled: in.Enabled,
+		Action:  string(in.Action),
+	}
+}
+
+// fromAWSApplicationLayerAutomaticResponse converts the AWS setting to its API representation
+func fromAWSApplicationLayerAutomaticResponse(in *aws.ApplicationLayerAutomaticResponse) *shieldawsv1alpha1.ApplicationLayerAutomaticResponse {
+	if in == nil {
+		return nil
+	}
+	return &shieldawsv1alpha1.ApplicationLayerAutomaticResponse{
+		Enabled: in.Enabled,
+		Action:  shieldawsv1alpha1.ApplicationLayerAutomaticResponseAction(in.Action),
+	}
+}
+
+// toAWSEmergencyContacts converts the API contacts to their AWS representation
+func toAWSEmergencyContacts(in []shieldawsv1alpha1.EmergencyContact) []aws.EmergencyContact {
+	var out []aws.EmergencyContact
+	for _, contact := range in {
+		out = append(out, aws.EmergencyContact{
+			EmailAddress: contact.EmailAddress,
+			PhoneNumber:  contact.PhoneNumber,
+			ContactNotes: contact.ContactNotes,
+		})
+	}
+	return out
+}
+
+// fromAWSEmergencyContacts converts the AWS contacts to their API representation
+func fromAWSEmergencyContacts(in []aws.EmergencyContact) []shieldawsv1alpha1.EmergencyContact {
+	var out []shieldawsv1alpha1.EmergencyContact
+	for _, contact := range in {
+		out = append(out, shieldawsv1alpha1.EmergencyContact{
+			EmailAddress: contact.EmailAddress,
+			PhoneNumber:  contact.PhoneNumber,
+			ContactNotes: contact.ContactNotes,
+		})
+	}
+	return out
+}
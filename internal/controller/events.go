@@ -0,0 +1,164 @@
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+)
+
+// Reasons of the events recorded on resources
+const (
+	// EventReasonProtectionCreated is used when a Shield protection is created for a resource
+	EventReasonProtectionCreated = "ProtectionCreated"
+
+	// EventReasonProtectionAdopted is used when a pre-existing Shield protection is adopted for a resource
+	EventReasonProtectionAdopted = "ProtectionAdopted"
+
+	// EventReasonProtectionDeleted is used when a Shield protection is deleted along with its resource
+	EventReasonProtectionDeleted = "ProtectionDeleted"
+
+	// EventReasonProtectionRetained is used when a Shield protection is released instead of deleted along with its resource
+	EventReasonProtectionRetained = "ProtectionRetained"
+
+	// EventReasonProtectionPruned is used when the Shield protection of a resource that no longer matches a policy is deleted
+	EventReasonProtectionPruned = "ProtectionPruned"
+
+	// EventReasonDiscoveryFailed is used when some resources of a policy could not be discovered
+	EventReasonDiscoveryFailed = "DiscoveryFailed"
+
+	// EventReasonShieldAPIError is used when a call to the Shield API fails
+	EventReasonShieldAPIError = "ShieldAPIError"
+
+	// EventReasonSubscriptionCreated is used when the Shield Advanced subscription is created
+	EventReasonSubscriptionCreated = "SubscriptionCreated"
+
+	// EventReasonSubscriptionUpdated is used when the Shield Advanced subscription is updated
+	EventReasonSubscriptionUpdated = "SubscriptionUpdated"
+
+	// EventReasonEmergencyContactsUpdated is used when the emergency contacts of the account are updated
+	EventReasonEmergencyContactsUpdated = "EmergencyContactsUpdated"
+
+	// EventReasonProactiveEngagementEnabled is used when proactive engagement is turned on
+	EventReasonProactiveEngagementEnabled = "ProactiveEngagementEnabled"
+
+	// EventReasonProactiveEngagementDisabled is used when proactive engagement is turned off
+	EventReasonProactiveEngagementDisabled = "ProactiveEngagementDisabled"
+
+	// EventReasonSRTAccessGranted is used when the SRT is granted access to a role or log bucket
+	EventReasonSRTAccessGranted = "SRTAccessGranted"
+
+	// EventReasonSRTAccessRevoked is used when the access of the SRT to a role or log bucket is revoked
+	EventReasonSRTAccessRevoked = "SRTAccessRevoked"
+
+	// EventReasonAttackDetected is used when Shield Advanced detects an attack on a protected resource
+	EventReasonAttackDetected = "AttackDetected"
+
+	// EventReasonAttackEnded is used when an attack on a protected resource ends
+	EventReasonAttackEnded = "AttackEnded"
+
+	// EventReasonNotificationFailed is used when the start or end of an attack could not be notified to a notification channel
+	EventReasonNotificationFailed = "NotificationFailed"
+)
+
+// EventInterval is the minimum interval between two identical events recorded on the same resource,
+// so that a resource failing on every reconciliation does not flood its events
+var EventInterval = 5 * time.Minute
+
+type eventKey struct {
+	uid       string
+	eventType string
+	reason    string
+	message   string
+}
+
+// rateLimitedRecorder drops the events identical to an event recorded on the same resource less than an interval ago
+type rateLimitedRecorder struct {
+	recorder record.EventRecorder
+	interval time.Duration
+	now      func() time.Time
+
+	mu     sync.Mutex
+	recent map[eventKey]time.Time
+}
+
+var _ record.EventRecorder = &rateLimitedRecorder{}
+
+// NewEventRecorder returns an event recorder that rate-limits the repeats of the events of the given recorder
+func NewEventRecorder(recorder record.EventRecorder) record.EventRecorder {
+	return &rateLimitedRecorder{
+		recorder: recorder,
+		interval: EventInterval,
+		now:      time.Now,
+		recent:   map[eventKey]time.Time{},
+	}
+}
+
+func (r *rateLimitedRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if r.allow(object, eventtype, reason, message) {
+		r.recorder.Event(object, eventtype, reason, message)
+	}
+}
+
+func (r *rateLimitedRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *rateLimitedRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if r.allow(object, eventtype, reason, message) {
+		r.recorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+	}
+}
+
+// allow reports whether an event is recorded, and remembers it when it is
+func (r *rateLimitedRecorder) allow(object runtime.Object, eventtype, reason, message string) bool {
+	key := eventKey{eventType: eventtype, reason: reason, message: message}
+	if obj, ok := object.(client.Object); ok {
+		key.uid = string(obj.GetUID())
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	for k, recorded := range r.recent {
+		if now.Sub(recorded) >= r.interval {
+			delete(r.recent, k)
+		}
+	}
+
+	if _, ok := r.recent[key]; ok {
+		return false
+	}
+	r.recent[key] = now
+
+	return true
+}
+
+// recordProtected records that the protection of a resource was created or adopted on behalf of obj
+func recordProtected(recorder record.EventRecorder, obj runtime.Object, resourceArn string, result *aws.ProtectionResult) {
+	switch result.Origin {
+	case aws.OriginCreated:
+		recorder.Eventf(obj, corev1.EventTypeNormal, EventReasonProtectionCreated, "Created protection %s of resource %s", result.ProtectionArn, resourceArn)
+	case aws.OriginAdopted:
+		recorder.Eventf(obj, corev1.EventTypeNormal, EventReasonProtectionAdopted, "Adopted protection %s of resource %s", result.ProtectionArn, resourceArn)
+	}
+}
+
+// recordRemoved records that the protection of a resource was removed according to the deletion policy of obj
+func recordRemoved(recorder record.EventRecorder, obj runtime.Object, resourceArn string, policy shieldawsv1alpha1.DeletionPolicy) {
+	if policy == shieldawsv1alpha1.DeletionPolicyRetain {
+		recorder.Eventf(obj, corev1.EventTypeNormal, EventReasonProtectionRetained, "Retained protection of resource %s", resourceArn)
+		return
+	}
+	recorder.Eventf(obj, corev1.EventTypeNormal, EventReasonProtectionDeleted, "Deleted protection of resource %s", resourceArn)
+}
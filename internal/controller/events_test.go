@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+)
+
+func TestRateLimitedRecorder(t *testing.T) {
+	fake := record.NewFakeRecorder(10)
+	now := time.Now()
+	recorder := &rateLimitedRecorder{
+		recorder: fake,
+		interval: time.Minute,
+		now:      func() time.Time { return now },
+		recent:   map[eventKey]time.Time{},
+	}
+
+	policy := &shieldawsv1alpha1.ProtectionPolicy{ObjectMeta: metav1.ObjectMeta{UID: "policy"}}
+	other := &shieldawsv1alpha1.ProtectionPolicy{ObjectMeta: metav1.ObjectMeta{UID: "other"}}
+
+	recorder.Eventf(policy, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to protect resource %s", "a")
+	recorder.Eventf(policy, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to protect resource %s", "a")
+	recorder.Eventf(policy, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to protect resource %s", "b")
+	recorder.Eventf(other, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to protect resource %s", "a")
+	assert.Len(t, fake.Events, 3)
+
+	// Repeats are recorded again once the interval has elapsed
+	now = now.Add(time.Minute)
+	recorder.Eventf(policy, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to protect resource %s", "a")
+	assert.Len(t, fake.Events, 4)
+}
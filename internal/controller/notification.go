@@ -0,0 +1,211 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/notify"
+)
+
+// notifyAttacks notifies the channels left to notify of the start and end of the attacks, and removes from the attacks
+// the channels that were notified. A notification that fails is sent again on the next poll, to the failed channels only.
+// The end of an attack is only notified to a channel after its start, and channels no longer referenced by obj are not notified.
+func (m *AttackMonitor) notifyAttacks(ctx context.Context, obj client.Object, refs []shieldawsv1alpha1.NotificationChannelReference, attacks []shieldawsv1alpha1.AttackStatus) {
+	log := log.FromContext(ctx)
+
+	referenced := channelNames(refs)
+	pending := map[string]bool{}
+	for i := range attacks {
+		attack := &attacks[i]
+		attack.StartPendingChannels = referencedChannels(attack.StartPendingChannels, referenced)
+		attack.EndPendingChannels = referencedChannels(attack.EndPendingChannels, referenced)
+		for _, name := range attack.StartPendingChannels {
+			pending[name] = true
+		}
+		for _, name := range attack.EndPendingChannels {
+			pending[name] = true
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	// A channel that cannot be used stays pending, without holding up the other channels
+	notifiers := map[string]notify.Notifier{}
+	for _, name := range referenced {
+		if !pending[name] {
+			continue
+		}
+		notifier, err := m.notifier(ctx, obj.GetNamespace(), name)
+		if err != nil {
+			log.Error(err, "Failed to get notification channel", "notificationChannel", name)
+			m.Recorder.Eventf(obj, corev1.EventTypeWarning, EventReasonNotificationFailed, "Failed to get notification channel %s: %v", name, err)
+			continue
+		}
+		notifiers[name] = notifier
+	}
+
+	source := m.source(obj)
+	for i := range attacks {
+		attack := &attacks[i]
+		attack.StartPendingChannels = m.send(ctx, obj, notifiers, attack.StartPendingChannels, toNotification(notify.EventAttackStarted, source, attack))
+
+		var ready, waiting []string
+		for _, name := range attack.EndPendingChannels {
+			if slices.Contains(attack.StartPendingChannels, name) {
+				waiting = append(waiting, name)
+			} else {
+				ready = append(ready, name)
+			}
+		}
+		attack.EndPendingChannels = append(waiting, m.send(ctx, obj, notifiers, ready, toNotification(notify.EventAttackEnded, source, attack))...)
+		slices.Sort(attack.EndPendingChannels)
+	}
+}
+
+// send sends the notification to the notifiers of the channels, and returns the channels it could not be sent to
+func (m *AttackMonitor) send(ctx context.Context, obj client.Object, notifiers map[string]notify.Notifier, channels []string, notification notify.Notification) []string {
+	log := log.FromContext(ctx)
+
+	var failed []string
+	for _, name := range channels {
+		notifier, ok := notifiers[name]
+		if !ok {
+			failed = append(failed, name)
+			continue
+		}
+		if err := notifier.Notify(ctx, notification); err != nil {
+			log.Error(err, "Failed to send notification", "notificationChannel", name, "attackId", notification.AttackId, "event", notification.Event)
+			m.Recorder.Eventf(obj, corev1.EventTypeWarning, EventReasonNotificationFailed, "Failed to notify channel %s of attack %s: %v",
+				name, notification.AttackId, err)
+			failed = append(failed, name)
+			continue
+		}
+		log.V(1).Info("Sent notification", "notificationChannel", name, "attackId", notification.AttackId, "event", notification.Event)
+	}
+	return failed
+}
+
+// channelNames returns the sorted names of the referenced channels, or nil when there are none
+func channelNames(refs []shieldawsv1alpha1.NotificationChannelReference) []string {
+	var names []string
+	for _, ref := range refs {
+		if !slices.Contains(names, ref.Name) {
+			names = append(names, ref.Name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// referencedChannels returns the channels that are referenced, or nil when there are none
+func referencedChannels(channels, referenced []string) []string {
+	var kept []string
+	for _, name := range channels {
+		if slices.Contains(referenced, name) {
+			kept = append(kept, name)
+		}
+	}
+	return kept
+}
+
+// notifier returns the notifier of the channel of the namespace
+func (m *AttackMonitor) notifier(ctx context.Context, namespace, name string) (notify.Notifier, error) {
+	channel := &shieldawsv1alpha1.NotificationChannel{}
+	if err := m.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, channel); err != nil {
+		return nil, err
+	}
+
+	spec := channel.Spec
+	switch {
+	case spec.Webhook != nil:
+		url, err := m.secretValue(ctx, channel.Namespace, spec.Webhook.URLSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		return notify.NewWebhookNotifier(url, m.HTTPClient), nil
+	case spec.Slack != nil:
+		url, err := m.secretValue(ctx, channel.Namespace, spec.Slack.URLSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		return notify.NewSlackNotifier(url, m.HTTPClient), nil
+	case spec.SNS != nil:
+		if m.SNS == nil {
+			return nil, fmt.Errorf("SNS notifications are not available")
+		}
+		// Topics are published to with the controller's credentials, so only the topics allowed by the operator are
+		if !snsTopicAllowed(m.Config.SNSTopicArns, spec.SNS.TopicArn) {
+			return nil, fmt.Errorf("SNS topic %s is not allowed by the controller", spec.SNS.TopicArn)
+		}
+		return notify.NewSNSNotifier(m.SNS, spec.SNS.TopicArn), nil
+	}
+	return nil, fmt.Errorf("no webhook, slack or sns destination")
+}
+
+// snsTopicAllowed returns whether the topic matches one of the allowed topic ARNs or patterns
+func snsTopicAllowed(allowed []string, topicArn string) bool {
+	for _, pattern := range allowed {
+		if matched, err := path.Match(pattern, topicArn); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// secretValue returns the value of the key of a Secret of the namespace
+func (m *AttackMonitor) secretValue(ctx context.Context, namespace string, ref shieldawsv1alpha1.SecretKeyReference) (string, error) {
+	secret := &corev1.Secret{}
+	if err := m.APIReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		return "", fmt.Errorf("failed to get secret %s: %w", ref.Name, err)
+	}
+
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("secret %s has no key %s", ref.Name, ref.Key)
+	}
+	return string(value), nil
+}
+
+// source identifies obj in notifications, as kind/namespace/name
+func (m *AttackMonitor) source(obj client.Object) string {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if gvk, err := apiutil.GVKForObject(obj, m.Scheme()); err == nil {
+		kind = gvk.Kind
+	}
+	return fmt.Sprintf("%s/%s/%s", kind, obj.GetNamespace(), obj.GetName())
+}
+
+// toNotification returns the notification of an event of the attack
+func toNotification(event notify.Event, source string, attack *shieldawsv1alpha1.AttackStatus) notify.Notification {
+	return notify.Notification{
+		Event:       event,
+		AttackId:    attack.AttackId,
+		ResourceArn: attack.ResourceArn,
+		StartTime:   timeOrNil(attack.StartTime),
+		EndTime:     timeOrNil(attack.EndTime),
+		Vectors:     attack.Vectors,
+		Mitigations: attack.Mitigations,
+		Source:      source,
+	}
+}
+
+// timeOrNil returns the time of t, or nil when t is nil
+func timeOrNil(t *metav1.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
+)
+
+const (
+	// ProtectionKind is the kind recorded on protections owned by a Protection resource
+	ProtectionKind = "Protection"
+
+	// ProtectionPolicyKind is the kind recorded on protections owned by a ProtectionPolicy resource
+	ProtectionPolicyKind = "ProtectionPolicy"
+
+	// ProtectionGroupKind is the kind recorded on protection groups owned by a ProtectionGroup resource
+	ProtectionGroupKind = "ProtectionGroup"
+)
+
+// newOwner returns the owner identity recorded on Shield resources managed on behalf of obj
+func newOwner(kind string, obj metav1.Object) aws.Owner {
+	return aws.Owner{
+		Kind:      kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		UID:       string(obj.GetUID()),
+	}
+}
+
+// deletionPolicy returns the deletion policy of a resource, falling back to the controller default when unset
+func deletionPolicy(cfg *config.Config, policy shieldawsv1alpha1.DeletionPolicy) shieldawsv1alpha1.DeletionPolicy {
+	if policy != "" {
+		return policy
+	}
+	if cfg.DefaultDeletionPolicy != "" {
+		return cfg.DefaultDeletionPolicy
+	}
+	return shieldawsv1alpha1.DeletionPolicyDelete
+}
+
+// removeProtection deletes an owned protection, or releases it from the controller when the deletion policy retains it
+func removeProtection(ctx context.Context, m aws.ShieldManager, protectionArn string, policy shieldawsv1alpha1.DeletionPolicy) error {
+	if policy == shieldawsv1alpha1.DeletionPolicyRetain {
+		return m.ReleaseProtection(ctx, protectionArn)
+	}
+	return m.DeleteProtection(ctx, protectionArn)
+}
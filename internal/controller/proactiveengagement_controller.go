@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
+)
+
+// ProactiveEngagementReconciler reconciles a ProactiveEngagement object
+type ProactiveEngagementReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	Config        *config.Config
+	ShieldManager aws.ShieldManager
+	Recorder      record.EventRecorder
+	Subscription  *SubscriptionMonitor
+}
+
+//+kubebuilder:rbac:groups=shield.aws.geode.io,resources=proactiveengagements,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=shield.aws.geode.io,resources=proactiveengagements/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *ProactiveEngagementReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	// Fetch the ProactiveEngagement instance
+	engagement := &shieldawsv1alpha1.ProactiveEngagement{}
+	if err := r.Get(ctx, req.NamespacedName, engagement); err != nil {
+		if apierrors.IsNotFound(err) {
+			// ProactiveEngagement resource not found, no need to requeue
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object, requeue the request
+		return ctrl.Result{}, err
+	}
+
+	original := engagement.DeepCopy()
+
+	status := &engagement.Status.ReconcileStatus
+	generation := engagement.Generation
+
+	// Emergency contacts and proactive engagement require an active subscription, which is checked again after the check interval
+	if err := r.Subscription.syncSubscriptionStatus(status, generation); err != nil {
+		log.Info("Skipping update of emergency contacts and proactive engagement", "reason", err.Error())
+		markFailed(status, generation, shieldawsv1alpha1.ReasonSubscriptionInactive, err)
+		return ctrl.Result{RequeueAfter: r.Config.SubscriptionCheckInterval}, updateStatus(ctx, r.Client, original, engagement, nil)
+	}
+
+	contacts, state, err := r.observe(ctx)
+	if err != nil {
+		log.Error(err, "Failed to get emergency contacts and proactive engagement")
+		markFailed(status, generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+		return ctrl.Result{}, updateStatus(ctx, r.Client, original, engagement, err)
+	}
+
+	desired := toAWSEmergencyContacts(engagement.Spec.EmergencyContacts)
+	inSync := slices.Equal(contacts, desired) && proactiveEngagementEnabled(state) == engagement.Spec.Enabled
+
+	if !inSync && r.Config.DryRun {
+		log.Info("Dry-run: skipping update of emergency contacts and proactive engagement",
+			"emergencyContacts", len(desired),
+			"enabled", engagement.Spec.Enabled,
+		)
+		markDryRun(status, generation)
+	} else {
+		if !inSync {
+			if err := r.apply(ctx, engagement, desired, contacts, state); err != nil {
+				log.Error(err, "Failed to update emergency contacts and proactive engagement")
+				r.Recorder.Eventf(engagement, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to update emergency contacts and proactive engagement: %v", err)
+				markFailed(status, generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+				return ctrl.Result{}, updateStatus(ctx, r.Client, original, engagement, err)
+			}
+
+			// Read the settings back so that the status shows what AWS Shield Advanced applied
+			contacts, state, err = r.observe(ctx)
+			if err != nil {
+				log.Error(err, "Failed to get emergency contacts and proactive engagement")
+				markFailed(status, generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+				return ctrl.Result{}, updateStatus(ctx, r.Client, original, engagement, err)
+			}
+		}
+		markSynced(status, generation)
+	}
+
+	engagement.Status.EmergencyContacts = fromAWSEmergencyContacts(contacts)
+	engagement.Status.State = state
+
+	if err := updateStatus(ctx, r.Client, original, engagement, nil); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Requeue after the subscription check interval so that changes made outside of the controller are corrected
+	log.V(1).Info("Requeueing after subscription check interval", "interval", r.Config.SubscriptionCheckInterval)
+	return ctrl.Result{
+		RequeueAfter: r.Config.SubscriptionCheckInterval,
+	}, nil
+}
+
+// observe returns the current emergency contacts and proactive engagement status of the account
+func (r *ProactiveEngagementReconciler) observe(ctx context.Context) ([]aws.EmergencyContact, string, error) {
+	contacts, err := r.ShieldManager.GetEmergencyContacts(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	subscription, err := r.ShieldManager.GetSubscription(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return contacts, subscription.ProactiveEngagementStatus, nil
+}
+
+// apply updates the emergency contacts and proactive engagement to match the spec. Proactive engagement is turned off
+// before and turned on after the contacts are updated, as it requires a contact with a phone number while it is on.
+func (r *ProactiveEngagementReconciler) apply(ctx context.Context, engagement *shieldawsv1alpha1.ProactiveEngagement, desired, contacts []aws.EmergencyContact, state string) error {
+	enabled := proactiveEngagementEnabled(state)
+
+	if !engagement.Spec.Enabled && enabled {
+		if err := r.ShieldManager.SetProactiveEngagement(ctx, false); err != nil {
+			return err
+		}
+		r.Recorder.Event(engagement, corev1.EventTypeNormal, EventReasonProactiveEngagementDisabled, "Disabled proactive engagement")
+	}
+
+	// Proactive engagement is set up along with the contacts the first time it is turned on
+	if engagement.Spec.Enabled && state == "" {
+		if err := r.ShieldManager.AssociateProactiveEngagement(ctx, desired); err != nil {
+			return err
+		}
+		r.Recorder.Event(engagement, corev1.EventTypeNormal, EventReasonEmergencyContactsUpdated, "Associated emergency contacts with proactive engagement")
+		contacts = desired
+
+		_, state, err := r.observe(ctx)
+		if err != nil {
+			return err
+		}
+		enabled = proactiveEngagementEnabled(state)
+	}
+
+	if !slices.Equal(contacts, desired) {
+		if err := r.ShieldManager.UpdateEmergencyContacts(ctx, desired); err != nil {
+			return err
+		}
+		r.Recorder.Eventf(engagement, corev1.EventTypeNormal, EventReasonEmergencyContactsUpdated, "Updated emergency contacts to %d contacts", len(desired))
+	}
+
+	if engagement.Spec.Enabled && !enabled {
+		if err := r.ShieldManager.SetProactiveEngagement(ctx, true); err != nil {
+			return err
+		}
+		r.Recorder.Event(engagement, corev1.EventTypeNormal, EventReasonProactiveEngagementEnabled, "Enabled proactive engagement")
+	}
+
+	return nil
+}
+
+// proactiveEngagementEnabled reports whether proactive engagement is on or being turned on
+func proactiveEngagementEnabled(state string) bool {
+	return state == "ENABLED" || state == "PENDING"
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ProactiveEngagementReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&shieldawsv1alpha1.ProactiveEngagement{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/record"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+)
+
+// fakeEngagementManager records the calls changing emergency contacts and proactive engagement
+type fakeEngagementManager struct {
+	aws.ShieldManager
+
+	state string
+	calls []string
+}
+
+func (m *fakeEngagementManager) GetEmergencyContacts(ctx context.Context) ([]aws.EmergencyContact, error) {
+	return nil, nil
+}
+
+func (m *fakeEngagementManager) GetSubscription(ctx context.Context) (*aws.Subscription, error) {
+	return &aws.Subscription{Active: true, ProactiveEngagementStatus: m.state}, nil
+}
+
+func (m *fakeEngagementManager) UpdateEmergencyContacts(ctx context.Context, contacts []aws.EmergencyContact) error {
+	m.calls = append(m.calls, fmt.Sprintf("UpdateEmergencyContacts(%d)", len(contacts)))
+	return nil
+}
+
+func (m *fakeEngagementManager) AssociateProactiveEngagement(ctx context.Context, contacts []aws.EmergencyContact) error {
+	m.calls = append(m.calls, fmt.Sprintf("AssociateProactiveEngagement(%d)", len(contacts)))
+	m.state = "DISABLED"
+	return nil
+}
+
+func (m *fakeEngagementManager) SetProactiveEngagement(ctx context.Context, enabled bool) error {
+	m.calls = append(m.calls, fmt.Sprintf("SetProactiveEngagement(%t)", enabled))
+	return nil
+}
+
+func TestProactiveEngagementReconciler_Apply(t *testing.T) {
+	oncall := aws.EmergencyContact{EmailAddress: "oncall@example.com", PhoneNumber: "+15555550100"}
+	security := aws.EmergencyContact{EmailAddress: "security@example.com"}
+
+	tests := []struct {
+		name     string
+		enabled  bool
+		desired  []aws.EmergencyContact
+		contacts []aws.EmergencyContact
+		state    string
+		calls    []string
+	}{
+		{
+			name:    "Enable for the first time",
+			enabled: true,
+			desired: []aws.EmergencyContact{oncall},
+			calls:   []string{"AssociateProactiveEngagement(1)", "SetProactiveEngagement(true)"},
+		},
+		{
+			name:     "Enable with new contacts",
+			enabled:  true,
+			desired:  []aws.EmergencyContact{oncall},
+			contacts: []aws.EmergencyContact{security},
+			state:    "DISABLED",
+			calls:    []string{"UpdateEmergencyContacts(1)", "SetProactiveEngagement(true)"},
+		},
+		{
+			name:     "Disable and remove contacts",
+			desired:  []aws.EmergencyContact{security},
+			contacts: []aws.EmergencyContact{oncall},
+			state:    "ENABLED",
+			calls:    []string{"SetProactiveEngagement(false)", "UpdateEmergencyContacts(1)"},
+		},
+		{
+			name:     "Update contacts only",
+			desired:  []aws.EmergencyContact{security},
+			contacts: []aws.EmergencyContact{oncall},
+			calls:    []string{"UpdateEmergencyContacts(1)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := &fakeEngagementManager{state: tt.state}
+			reconciler := &ProactiveEngagementReconciler{
+				ShieldManager: manager,
+				Recorder:      record.NewFakeRecorder(10),
+			}
+			engagement := &shieldawsv1alpha1.ProactiveEngagement{
+				Spec: shieldawsv1alpha1.ProactiveEngagementSpec{Enabled: tt.enabled},
+			}
+
+			err := reconciler.apply(context.Background(), engagement, tt.desired, tt.contacts, tt.state)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.calls, manager.calls)
+		})
+	}
+}
@@ -2,13 +2,18 @@ package controller
 
 import (
 	"context"
+	"errors"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
 
@@ -23,11 +28,14 @@ type ProtectionReconciler struct {
 
 	Config        *config.Config
 	ShieldManager aws.ShieldManager
+	Recorder      record.EventRecorder
+	Subscription  *SubscriptionMonitor
 }
 
 //+kubebuilder:rbac:groups=shield.aws.geode.io,resources=protections,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=shield.aws.geode.io,resources=protections/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=shield.aws.geode.io,resources=protections/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *ProtectionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
@@ -43,6 +51,10 @@ func (r *ProtectionReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
+	original := protection.DeepCopy()
+
+	owner := newOwner(ProtectionKind, protection)
+
 	// Add the finalizer if it doesn't exist
 	if !controllerutil.ContainsFinalizer(protection, FinalizerName) {
 		controllerutil.AddFinalizer(protection, FinalizerName)
@@ -58,15 +70,29 @@ func (r *ProtectionReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		// Protection is marked for deletion
 		if controllerutil.ContainsFinalizer(protection, FinalizerName) {
 
-			// Delete the resource protection in AWS
+			// Delete or retain the resource protection in AWS, but only if it is owned by this Protection
+			policy := deletionPolicy(r.Config, protection.Spec.DeletionPolicy)
 			if !r.Config.DryRun {
-				err := r.ShieldManager.DeleteProtection(ctx, protection.Status.ProtectionArn)
+				owned, err := r.ShieldManager.ListOwnedProtections(ctx, owner)
 				if err != nil {
-					log.Error(err, "Failed to delete resource protection")
-					return ctrl.Result{}, err
+					log.Error(err, "Failed to list owned protections")
+					r.Recorder.Eventf(protection, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to list owned protections: %v", err)
+					markFailed(&protection.Status.ReconcileStatus, protection.Generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+					return ctrl.Result{}, updateStatus(ctx, r.Client, original, protection, err)
+				}
+
+				for _, existing := range owned {
+					err := removeProtection(ctx, r.ShieldManager, *existing.ProtectionArn, policy)
+					if err != nil {
+						log.Error(err, "Failed to remove resource protection", "deletionPolicy", policy)
+						r.Recorder.Eventf(protection, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to remove protection of resource %s: %v", *existing.ResourceArn, err)
+						markFailed(&protection.Status.ReconcileStatus, protection.Generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+						return ctrl.Result{}, updateStatus(ctx, r.Client, original, protection, err)
+					}
+					recordRemoved(r.Recorder, protection, *existing.ResourceArn, policy)
 				}
 			} else {
-				log.Info("Dry-run: skipping deletion of protection", "protectionArn", protection.Status.ProtectionArn)
+				log.Info("Dry-run: skipping deletion of protection", "protectionArn", protection.Status.ProtectionArn, "deletionPolicy", policy)
 			}
 
 			// Remove the finalizer
@@ -80,40 +106,111 @@ func (r *ProtectionReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
+	// Protections cannot be created without an active subscription, which is checked again after the resync interval
+	if err := r.Subscription.syncSubscriptionStatus(&protection.Status.ReconcileStatus, protection.Generation); err != nil {
+		log.Info("Skipping creation or update of protection", "reason", err.Error())
+		protection.Status.State = shieldawsv1alpha1.ProtectionStateInactive
+		markFailed(&protection.Status.ReconcileStatus, protection.Generation, shieldawsv1alpha1.ReasonSubscriptionInactive, err)
+		return ctrl.Result{RequeueAfter: r.Config.ProtectionResyncInterval}, updateStatus(ctx, r.Client, original, protection, nil)
+	}
+
 	if r.Config.DryRun {
 		log.Info("Dry-run: skipping creation or update of protection",
 			"name", protection.Name,
 			"resourceArn", protection.Spec.ResourceArn,
 		)
-		return ctrl.Result{}, nil
+		markDryRun(&protection.Status.ReconcileStatus, protection.Generation)
+		return ctrl.Result{}, updateStatus(ctx, r.Client, original, protection, nil)
 	}
 
 	// Create or update the resource protection in AWS Shield Advanced
-	protectionArn, err := r.ShieldManager.CreateOrUpdateProtection(
-		ctx,
-		protection.Name,
-		protection.Spec.ResourceArn,
-	)
+	result, err := r.ShieldManager.CreateOrUpdateProtection(ctx, aws.ProtectionRequest{
+		Name:                              protection.Name,
+		ResourceArn:                       protection.Spec.ResourceArn,
+		ApplicationLayerAutomaticResponse: toAWSApplicationLayerAutomaticResponse(protection.Spec.ApplicationLayerAutomaticResponse),
+		HealthCheckArns:                   protection.Spec.HealthCheckArns,
+		PreviousHealthCheckArns:           protection.Status.ManagedHealthCheckArns,
+		AdoptionPolicy:                    string(protection.Spec.AdoptionPolicy),
+	}, owner)
+	if errors.Is(err, aws.ErrInvalidResourceArn) {
+		// Retrying cannot help until the spec is fixed, which triggers a new reconciliation
+		log.Error(err, "Invalid resource ARN")
+		protection.Status.State = shieldawsv1alpha1.ProtectionStateInactive
+		markFailed(&protection.Status.ReconcileStatus, protection.Generation, shieldawsv1alpha1.ReasonInvalidResourceArn, err)
+		return ctrl.Result{}, updateStatus(ctx, r.Client, original, protection, nil)
+	}
 	if err != nil {
 		log.Error(err, "Failed to create or update resource protection")
-		return ctrl.Result{}, err
+		r.Recorder.Eventf(protection, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to protect resource %s: %v", protection.Spec.ResourceArn, err)
+		protection.Status.State = shieldawsv1alpha1.ProtectionStateInactive
+		markFailed(&protection.Status.ReconcileStatus, protection.Generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+		return ctrl.Result{}, updateStatus(ctx, r.Client, original, protection, err)
+	}
+
+	// The protection of the previous resource is deleted or retained like on deletion once its resource changed,
+	// so that it is neither left behind nor managed on behalf of the object
+	if previous := protection.Status.ResourceArn; previous != "" && previous != protection.Spec.ResourceArn {
+		if err := r.removePreviousProtections(ctx, protection, owner); err != nil {
+			log.Error(err, "Failed to remove protection of previous resource", "resourceArn", previous)
+			markFailed(&protection.Status.ReconcileStatus, protection.Generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+			return ctrl.Result{}, updateStatus(ctx, r.Client, original, protection, err)
+		}
+	}
+
+	if protection.Status.ProtectionArn != result.ProtectionArn {
+		recordProtected(r.Recorder, protection, protection.Spec.ResourceArn, result)
 	}
 
 	// Update resource status
-	protection.Status.ProtectionArn = protectionArn
+	protection.Status.ProtectionArn = result.ProtectionArn
+	protection.Status.Name = protection.Name
+	protection.Status.Origin = shieldawsv1alpha1.ProtectionOrigin(result.Origin)
+	protection.Status.ResourceArn = protection.Spec.ResourceArn
+	protection.Status.ApplicationLayerAutomaticResponse = fromAWSApplicationLayerAutomaticResponse(result.ApplicationLayerAutomaticResponse)
+	protection.Status.HealthCheckArns = result.HealthCheckArns
+	protection.Status.ManagedHealthCheckArns = nil
+	if result.Origin != aws.OriginUnmanaged {
+		protection.Status.ManagedHealthCheckArns = protection.Spec.HealthCheckArns
+	}
 	protection.Status.State = shieldawsv1alpha1.ProtectionStateActive
-	err = r.Status().Update(ctx, protection)
-	if err != nil {
-		log.Error(err, "Failed to update Protection status")
+	markSynced(&protection.Status.ReconcileStatus, protection.Generation)
+	if err := updateStatus(ctx, r.Client, original, protection, nil); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	// Requeue after the configured resync interval so that drift is corrected
+	log.V(1).Info("Requeueing after resync interval", "interval", r.Config.ProtectionResyncInterval)
+	return ctrl.Result{
+		RequeueAfter: r.Config.ProtectionResyncInterval,
+	}, nil
+}
+
+// removePreviousProtections removes the protections owned by the object other than that of its current resource,
+// according to its deletion policy
+func (r *ProtectionReconciler) removePreviousProtections(ctx context.Context, protection *shieldawsv1alpha1.Protection, owner aws.Owner) error {
+	owned, err := r.ShieldManager.ListOwnedProtections(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	policy := deletionPolicy(r.Config, protection.Spec.DeletionPolicy)
+	for _, existing := range owned {
+		if *existing.ResourceArn == protection.Spec.ResourceArn {
+			continue
+		}
+		err := removeProtection(ctx, r.ShieldManager, *existing.ProtectionArn, policy)
+		if err != nil {
+			r.Recorder.Eventf(protection, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to remove protection of resource %s: %v", *existing.ResourceArn, err)
+			return err
+		}
+		recordRemoved(r.Recorder, protection, *existing.ResourceArn, policy)
+	}
+	return nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ProtectionReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&shieldawsv1alpha1.Protection{}).
+		For(&shieldawsv1alpha1.Protection{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Complete(r)
 }
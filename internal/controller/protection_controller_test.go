@@ -2,16 +2,28 @@ package controller
 
 import (
 	"context"
+	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	shieldtypes "github.com/aws/aws-sdk-go-v2/service/shield/types"
+
 	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
 )
 
 var _ = Describe("Protection Controller", func() {
@@ -66,3 +78,92 @@ var _ = Describe("Protection Controller", func() {
 		})
 	})
 })
+
+// fakeProtectionManager protects resources and records the calls removing protections
+type fakeProtectionManager struct {
+	aws.ShieldManager
+
+	owned []shieldtypes.Protection
+	calls []string
+}
+
+func (m *fakeProtectionManager) CreateOrUpdateProtection(ctx context.Context, request aws.ProtectionRequest, owner aws.Owner) (*aws.ProtectionResult, error) {
+	return &aws.ProtectionResult{ProtectionArn: "arn:aws:shield::123456789012:protection/new", Origin: aws.OriginCreated}, nil
+}
+
+func (m *fakeProtectionManager) ListOwnedProtections(ctx context.Context, owner aws.Owner) ([]shieldtypes.Protection, error) {
+	return m.owned, nil
+}
+
+func (m *fakeProtectionManager) DeleteProtection(ctx context.Context, protectionArn string) error {
+	m.calls = append(m.calls, "DeleteProtection("+protectionArn+")")
+	return nil
+}
+
+func (m *fakeProtectionManager) ReleaseProtection(ctx context.Context, protectionArn string) error {
+	m.calls = append(m.calls, "ReleaseProtection("+protectionArn+")")
+	return nil
+}
+
+func TestProtectionReconciler_ResourceChanged(t *testing.T) {
+	previousArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/old/1234567890abcdef"
+	resourceArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/new/1234567890abcdef"
+
+	tests := []struct {
+		name   string
+		policy shieldawsv1alpha1.DeletionPolicy
+		calls  []string
+	}{
+		{
+			name:   "Delete the previous protection",
+			policy: shieldawsv1alpha1.DeletionPolicyDelete,
+			calls:  []string{"DeleteProtection(arn:aws:shield::123456789012:protection/old)"},
+		},
+		{
+			name:   "Retain the previous protection",
+			policy: shieldawsv1alpha1.DeletionPolicyRetain,
+			calls:  []string{"ReleaseProtection(arn:aws:shield::123456789012:protection/old)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			scheme := runtime.NewScheme()
+			assert.NoError(t, shieldawsv1alpha1.AddToScheme(scheme))
+
+			protection := &shieldawsv1alpha1.Protection{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", Finalizers: []string{FinalizerName}},
+				Spec:       shieldawsv1alpha1.ProtectionSpec{ResourceArn: resourceArn, DeletionPolicy: tt.policy},
+			}
+			protection.Status.ResourceArn = previousArn
+			protection.Status.ProtectionArn = "arn:aws:shield::123456789012:protection/old"
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(protection).
+				WithStatusSubresource(protection).
+				Build()
+			manager := &fakeProtectionManager{owned: []shieldtypes.Protection{
+				{ProtectionArn: awssdk.String("arn:aws:shield::123456789012:protection/old"), ResourceArn: awssdk.String(previousArn)},
+				{ProtectionArn: awssdk.String("arn:aws:shield::123456789012:protection/new"), ResourceArn: awssdk.String(resourceArn)},
+			}}
+			reconciler := &ProtectionReconciler{
+				Client:        c,
+				Scheme:        scheme,
+				Config:        &config.Config{},
+				ShieldManager: manager,
+				Recorder:      record.NewFakeRecorder(10),
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(protection)})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.calls, manager.calls)
+
+			assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(protection), protection))
+			assert.Equal(t, resourceArn, protection.Status.ResourceArn)
+			assert.Equal(t, "arn:aws:shield::123456789012:protection/new", protection.Status.ProtectionArn)
+		})
+	}
+}
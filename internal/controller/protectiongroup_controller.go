@@ -0,0 +1,299 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
+)
+
+// ProtectionGroupReconciler reconciles a ProtectionGroup object
+type ProtectionGroupReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	Config        *config.Config
+	ShieldManager aws.ShieldManager
+}
+
+//+kubebuilder:rbac:groups=shield.aws.geode.io,resources=protectiongroups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=shield.aws.geode.io,resources=protectiongroups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=shield.aws.geode.io,resources=protectiongroups/finalizers,verbs=update
+
+func (r *ProtectionGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	// Fetch the ProtectionGroup instance
+	group := &shieldawsv1alpha1.ProtectionGroup{}
+	if err := r.Get(ctx, req.NamespacedName, group); err != nil {
+		if apierrors.IsNotFound(err) {
+			// ProtectionGroup resource not found, no need to requeue
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object, requeue the request
+		return ctrl.Result{}, err
+	}
+	original := group.DeepCopy()
+
+	owner := newOwner(ProtectionGroupKind, group)
+
+	protectionGroupId := group.Spec.ProtectionGroupId
+	if protectionGroupId == "" {
+		protectionGroupId = group.Name
+	}
+
+	// The protection group recorded in status is the one that exists in AWS, which differs from the desired one
+	// when protectionGroupId was added or removed
+	managedId := group.Status.ProtectionGroupId
+	if managedId == "" {
+		managedId = protectionGroupId
+	}
+
+	// Add the finalizer if it doesn't exist
+	if !controllerutil.ContainsFinalizer(group, FinalizerName) {
+		controllerutil.AddFinalizer(group, FinalizerName)
+		err := r.Update(ctx, group)
+		if err != nil {
+			log.Error(err, "Failed to add finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Check if the ProtectionGroup instance is marked for deletion
+	if group.GetDeletionTimestamp() != nil {
+		// ProtectionGroup is marked for deletion
+		if controllerutil.ContainsFinalizer(group, FinalizerName) {
+
+			// Delete the protection group in AWS
+			if !r.Config.DryRun {
+				err := r.ShieldManager.DeleteProtectionGroup(ctx, managedId, owner)
+				if err != nil {
+					log.Error(err, "Failed to delete protection group")
+					return ctrl.Result{}, err
+				}
+			} else {
+				log.Info("Dry-run: skipping deletion of protection group", "protectionGroupId", managedId)
+			}
+
+			// Remove the finalizer
+			controllerutil.RemoveFinalizer(group, FinalizerName)
+			err := r.Update(ctx, group)
+			if err != nil {
+				log.Error(err, "Failed to remove finalizer")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Resolve the members of the protection group
+	members, err := r.resolveMembers(ctx, group)
+	if err != nil {
+		log.Error(err, "Failed to resolve protection group members")
+		return ctrl.Result{}, err
+	}
+
+	// AWS Shield Advanced rejects arbitrary protection groups without members, which are added once the referenced
+	// resources are protected
+	if group.Spec.Pattern == shieldawsv1alpha1.ProtectionGroupPatternArbitrary && len(members) == 0 {
+		log.Info("Protection group has no members, skipping creation or update", "protectionGroupId", protectionGroupId)
+		err := errors.New("protection group has no protected members")
+		markFailed(&group.Status.ReconcileStatus, group.Generation, shieldawsv1alpha1.ReasonNoMembers, err)
+		return ctrl.Result{}, updateStatus(ctx, r.Client, original, group, nil)
+	}
+
+	if r.Config.DryRun {
+		log.Info("Dry-run: skipping creation or update of protection group",
+			"protectionGroupId", protectionGroupId,
+			"members", members,
+		)
+		markDryRun(&group.Status.ReconcileStatus, group.Generation)
+		return ctrl.Result{}, updateStatus(ctx, r.Client, original, group, nil)
+	}
+
+	// Delete the previous protection group rather than leaking it when its ID changed
+	if managedId != protectionGroupId {
+		log.Info("Replacing protection group", "previousProtectionGroupId", managedId, "protectionGroupId", protectionGroupId)
+		err := r.ShieldManager.DeleteProtectionGroup(ctx, managedId, owner)
+		if err != nil {
+			log.Error(err, "Failed to delete previous protection group")
+			markFailed(&group.Status.ReconcileStatus, group.Generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+			return ctrl.Result{}, updateStatus(ctx, r.Client, original, group, err)
+		}
+	}
+
+	// Create or update the protection group in AWS Shield Advanced
+	protectionGroupArn, err := r.ShieldManager.CreateOrUpdateProtectionGroup(ctx, aws.ProtectionGroup{
+		Id:           protectionGroupId,
+		Aggregation:  string(group.Spec.Aggregation),
+		Pattern:      string(group.Spec.Pattern),
+		ResourceType: string(group.Spec.ResourceType),
+		Members:      members,
+	}, owner)
+	if err != nil {
+		log.Error(err, "Failed to create or update protection group")
+		markFailed(&group.Status.ReconcileStatus, group.Generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+		return ctrl.Result{}, updateStatus(ctx, r.Client, original, group, err)
+	}
+
+	// Update resource status
+	group.Status.ProtectionGroupId = protectionGroupId
+	group.Status.ProtectionGroupArn = protectionGroupArn
+	group.Status.Members = members
+	group.Status.State = shieldawsv1alpha1.ProtectionStateActive
+	markSynced(&group.Status.ReconcileStatus, group.Generation)
+	if err := updateStatus(ctx, r.Client, original, group, nil); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Requeue after the configured resync interval so that membership follows the referenced resources
+	log.V(1).Info("Requeueing after resync interval", "interval", r.Config.PolicyResyncInterval)
+	return ctrl.Result{
+		RequeueAfter: r.Config.PolicyResyncInterval,
+	}, nil
+}
+
+// resolveMembers returns the sorted resource ARNs of the members of an ARBITRARY protection group,
+// combining the explicit members with those of the referenced Protection and ProtectionPolicy resources
+func (r *ProtectionGroupReconciler) resolveMembers(ctx context.Context, group *shieldawsv1alpha1.ProtectionGroup) ([]string, error) {
+	log := log.FromContext(ctx)
+
+	if group.Spec.Pattern != shieldawsv1alpha1.ProtectionGroupPatternArbitrary {
+		return nil, nil
+	}
+
+	members := slices.Clone(group.Spec.Members)
+
+	for _, ref := range group.Spec.ProtectionRefs {
+		protection := &shieldawsv1alpha1.Protection{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: group.Namespace, Name: ref.Name}, protection)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Info("Referenced Protection not found, skipping", "protection", ref.Name)
+				continue
+			}
+			return nil, err
+		}
+
+		members = append(members, protectedResources(protection)...)
+	}
+
+	for _, ref := range group.Spec.ProtectionPolicyRefs {
+		policy := &shieldawsv1alpha1.ProtectionPolicy{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: group.Namespace, Name: ref.Name}, policy)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Info("Referenced ProtectionPolicy not found, skipping", "protectionPolicy", ref.Name)
+				continue
+			}
+			return nil, err
+		}
+
+		// Protections managed in other accounts cannot be members of a protection group of the controller's account
+		members = append(members, localProtectedResources(policy)...)
+	}
+
+	slices.Sort(members)
+	return slices.Compact(members), nil
+}
+
+// protectedResources returns the ARN of the resource of a protection once it is protected, as only protected
+// resources can be members of a protection group
+func protectedResources(protection *shieldawsv1alpha1.Protection) []string {
+	if protection.Status.State != shieldawsv1alpha1.ProtectionStateActive {
+		return nil
+	}
+	return []string{protection.Spec.ResourceArn}
+}
+
+// localProtectedResources returns the ARNs of the resources protected by a policy in the controller's own account
+func localProtectedResources(policy *shieldawsv1alpha1.ProtectionPolicy) []string {
+	local := map[string]bool{"": true}
+	for _, account := range policy.Status.Accounts {
+		if account.RoleArn == "" {
+			local[account.AccountId] = true
+		}
+	}
+
+	var resources []string
+	for _, protection := range policy.Status.Protections {
+		if protection.State == shieldawsv1alpha1.ProtectionStateActive && local[protection.AccountId] {
+			resources = append(resources, protection.ResourceArn)
+		}
+	}
+	return resources
+}
+
+// referencingGroups maps a Protection or ProtectionPolicy to the protection groups of its namespace referencing it
+func (r *ProtectionGroupReconciler) referencingGroups(ctx context.Context, obj client.Object) []reconcile.Request {
+	groups := &shieldawsv1alpha1.ProtectionGroupList{}
+	if err := r.List(ctx, groups, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list protection groups referencing resource", "resource", obj.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, group := range groups.Items {
+		var refs []corev1.LocalObjectReference
+		switch obj.(type) {
+		case *shieldawsv1alpha1.Protection:
+			refs = group.Spec.ProtectionRefs
+		case *shieldawsv1alpha1.ProtectionPolicy:
+			refs = group.Spec.ProtectionPolicyRefs
+		}
+		if slices.ContainsFunc(refs, func(ref corev1.LocalObjectReference) bool { return ref.Name == obj.GetName() }) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&group)})
+		}
+	}
+	return requests
+}
+
+// membersChanged filters the updates of referenced resources to those changing the members they contribute
+func membersChanged[T client.Object](members func(T) []string) predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			before, ok := e.ObjectOld.(T)
+			if !ok {
+				return true
+			}
+			after, ok := e.ObjectNew.(T)
+			if !ok {
+				return true
+			}
+			return !slices.Equal(members(before), members(after))
+		},
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ProtectionGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&shieldawsv1alpha1.ProtectionGroup{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&shieldawsv1alpha1.Protection{},
+			handler.EnqueueRequestsFromMapFunc(r.referencingGroups),
+			builder.WithPredicates(membersChanged(protectedResources)),
+		).
+		Watches(&shieldawsv1alpha1.ProtectionPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.referencingGroups),
+			builder.WithPredicates(membersChanged(localProtectedResources)),
+		).
+		Complete(r)
+}
@@ -0,0 +1,193 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
+)
+
+// fakeProtectionGroupManager records the calls changing protection groups
+type fakeProtectionGroupManager struct {
+	aws.ShieldManager
+
+	calls []string
+}
+
+func (m *fakeProtectionGroupManager) CreateOrUpdateProtectionGroup(ctx context.Context, group aws.ProtectionGroup, owner aws.Owner) (string, error) {
+	m.calls = append(m.calls, "CreateOrUpdateProtectionGroup("+group.Id+")")
+	return "arn:aws:shield::123456789012:protection-group/" + group.Id, nil
+}
+
+func (m *fakeProtectionGroupManager) DeleteProtectionGroup(ctx context.Context, protectionGroupId string, owner aws.Owner) error {
+	m.calls = append(m.calls, "DeleteProtectionGroup("+protectionGroupId+")")
+	return nil
+}
+
+func TestProtectionGroupReconciler_Reconcile(t *testing.T) {
+	deleted := metav1.NewTime(time.Now())
+
+	tests := []struct {
+		name       string
+		id         string
+		managedId  string
+		deleted    bool
+		dryRun     bool
+		pattern    shieldawsv1alpha1.ProtectionGroupPattern
+		calls      []string
+		expectedId string
+		reason     string
+	}{
+		{
+			name:       "Create with the name as ID",
+			calls:      []string{"CreateOrUpdateProtectionGroup(web)"},
+			expectedId: "web",
+			reason:     shieldawsv1alpha1.ReasonSucceeded,
+		},
+		{
+			name:       "Create with the ID",
+			id:         "web-group",
+			calls:      []string{"CreateOrUpdateProtectionGroup(web-group)"},
+			expectedId: "web-group",
+			reason:     shieldawsv1alpha1.ReasonSucceeded,
+		},
+		{
+			name:       "Replace the group when the ID changed",
+			id:         "web-group",
+			managedId:  "web",
+			calls:      []string{"DeleteProtectionGroup(web)", "CreateOrUpdateProtectionGroup(web-group)"},
+			expectedId: "web-group",
+			reason:     shieldawsv1alpha1.ReasonSucceeded,
+		},
+		{
+			name:      "Delete the managed group",
+			id:        "web-group",
+			managedId: "web",
+			deleted:   true,
+			calls:     []string{"DeleteProtectionGroup(web)"},
+		},
+		{
+			name:    "Skip an arbitrary group without members",
+			pattern: shieldawsv1alpha1.ProtectionGroupPatternArbitrary,
+			reason:  shieldawsv1alpha1.ReasonNoMembers,
+		},
+		{
+			name:   "Dry-run",
+			dryRun: true,
+			reason: shieldawsv1alpha1.ReasonDryRun,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			scheme := runtime.NewScheme()
+			assert.NoError(t, shieldawsv1alpha1.AddToScheme(scheme))
+
+			pattern := tt.pattern
+			if pattern == "" {
+				pattern = shieldawsv1alpha1.ProtectionGroupPatternAll
+			}
+			group := &shieldawsv1alpha1.ProtectionGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", Finalizers: []string{FinalizerName}},
+				Spec: shieldawsv1alpha1.ProtectionGroupSpec{
+					ProtectionGroupId: tt.id,
+					Aggregation:       "SUM",
+					Pattern:           pattern,
+				},
+				Status: shieldawsv1alpha1.ProtectionGroupStatus{ProtectionGroupId: tt.managedId},
+			}
+			if tt.deleted {
+				group.DeletionTimestamp = &deleted
+			}
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(group).
+				WithStatusSubresource(group).
+				Build()
+			manager := &fakeProtectionGroupManager{}
+			reconciler := &ProtectionGroupReconciler{
+				Client:        c,
+				Scheme:        scheme,
+				Config:        &config.Config{DryRun: tt.dryRun, PolicyResyncInterval: time.Hour},
+				ShieldManager: manager,
+			}
+
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(group)})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.calls, manager.calls)
+
+			if tt.expectedId != "" {
+				assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(group), group))
+				assert.Equal(t, tt.expectedId, group.Status.ProtectionGroupId)
+				assert.Equal(t, shieldawsv1alpha1.ProtectionStateActive, group.Status.State)
+			}
+			if tt.reason != "" {
+				assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(group), group))
+				for _, conditionType := range []string{shieldawsv1alpha1.ConditionTypeReady, shieldawsv1alpha1.ConditionTypeSynced} {
+					condition := meta.FindStatusCondition(group.Status.Conditions, conditionType)
+					if assert.NotNil(t, condition, conditionType) {
+						assert.Equal(t, tt.reason, condition.Reason, conditionType)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestProtectionGroupReconciler_ReferencingGroups(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, shieldawsv1alpha1.AddToScheme(scheme))
+
+	group := func(namespace, name string, spec shieldawsv1alpha1.ProtectionGroupSpec) *shieldawsv1alpha1.ProtectionGroup {
+		return &shieldawsv1alpha1.ProtectionGroup{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}, Spec: spec}
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			group("default", "by-protection", shieldawsv1alpha1.ProtectionGroupSpec{ProtectionRefs: []corev1.LocalObjectReference{{Name: "web"}}}),
+			group("default", "by-policy", shieldawsv1alpha1.ProtectionGroupSpec{ProtectionPolicyRefs: []corev1.LocalObjectReference{{Name: "web"}}}),
+			group("other", "by-protection", shieldawsv1alpha1.ProtectionGroupSpec{ProtectionRefs: []corev1.LocalObjectReference{{Name: "web"}}}),
+		).
+		Build()
+	reconciler := &ProtectionGroupReconciler{Client: c, Scheme: scheme}
+
+	requests := reconciler.referencingGroups(ctx, &shieldawsv1alpha1.Protection{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}})
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "default", Name: "by-protection"}}}, requests)
+
+	requests = reconciler.referencingGroups(ctx, &shieldawsv1alpha1.ProtectionPolicy{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}})
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "default", Name: "by-policy"}}}, requests)
+}
+
+func TestMembersChanged(t *testing.T) {
+	resourceArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/1234567890abcdef"
+	pending := &shieldawsv1alpha1.Protection{Spec: shieldawsv1alpha1.ProtectionSpec{ResourceArn: resourceArn}}
+	protected := pending.DeepCopy()
+	protected.Status.State = shieldawsv1alpha1.ProtectionStateActive
+	synced := protected.DeepCopy()
+	markSynced(&synced.Status.ReconcileStatus, 1)
+
+	changed := membersChanged(protectedResources)
+	assert.True(t, changed.Update(event.UpdateEvent{ObjectOld: pending, ObjectNew: protected}))
+	assert.False(t, changed.Update(event.UpdateEvent{ObjectOld: protected, ObjectNew: synced}))
+}
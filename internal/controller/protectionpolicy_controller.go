@@ -2,18 +2,33 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/prometheus/client_golang/prometheus"
 
 	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
 
 	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
 	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/filter"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/metrics"
 )
 
 // ProtectionPolicyReconciler reconciles a ProtectionPolicy object
@@ -21,14 +36,16 @@ type ProtectionPolicyReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
 
-	Config        *config.Config
-	ShieldManager aws.ShieldManager
-	Discovery     aws.DiscoveryClient
+	Config       *config.Config
+	Accounts     aws.AccountManager
+	Recorder     record.EventRecorder
+	Subscription *SubscriptionMonitor
 }
 
 //+kubebuilder:rbac:groups=shield.aws.geode.io,resources=protectionpolicies,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=shield.aws.geode.io,resources=protectionpolicies/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=shield.aws.geode.io,resources=protectionpolicies/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *ProtectionPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
@@ -44,6 +61,10 @@ func (r *ProtectionPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, err
 	}
 
+	original := policy.DeepCopy()
+
+	owner := newOwner(ProtectionPolicyKind, policy)
+
 	// Add the finalizer if it doesn't exist
 	if !controllerutil.ContainsFinalizer(policy, FinalizerName) {
 		controllerutil.AddFinalizer(policy, FinalizerName)
@@ -59,18 +80,45 @@ func (r *ProtectionPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		// Protection is marked for deletion
 		if controllerutil.ContainsFinalizer(policy, FinalizerName) {
 
-			// Delete all protection resources in AWS
+			// Delete or retain all protection resources in AWS owned by this policy
+			deletion := deletionPolicy(r.Config, policy.Spec.DeletionPolicy)
 			if !r.Config.DryRun {
-				protections := policy.Status.Protections
-				for _, protection := range protections {
-					err := r.ShieldManager.DeleteProtection(ctx, protection.ProtectionArn)
+				targets, err := r.accountTargets(ctx, policy)
+				if err != nil {
+					log.Error(err, "Failed to list organization accounts")
+					markFailed(&policy.Status.ReconcileStatus, policy.Generation, shieldawsv1alpha1.ReasonAccountError, err)
+					return ctrl.Result{}, updateStatus(ctx, r.Client, original, policy, err)
+				}
+
+				for _, target := range targets {
+					account, err := r.Accounts.Account(ctx, target)
 					if err != nil {
-						log.Error(err, "Failed to delete protection", "protection", protection.ProtectionArn)
-						return ctrl.Result{}, err
+						log.Error(err, "Failed to access account", "roleArn", target.RoleArn)
+						markFailed(&policy.Status.ReconcileStatus, policy.Generation, shieldawsv1alpha1.ReasonAccountError, err)
+						return ctrl.Result{}, updateStatus(ctx, r.Client, original, policy, err)
+					}
+
+					protections, err := account.ShieldManager.ListOwnedProtections(ctx, owner)
+					if err != nil {
+						log.Error(err, "Failed to list owned protections", "accountId", account.AccountId)
+						r.Recorder.Eventf(policy, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to list owned protections of account %s: %v", account.AccountId, err)
+						markFailed(&policy.Status.ReconcileStatus, policy.Generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+						return ctrl.Result{}, updateStatus(ctx, r.Client, original, policy, err)
+					}
+
+					for _, protection := range protections {
+						err := removeProtection(ctx, account.ShieldManager, *protection.ProtectionArn, deletion)
+						if err != nil {
+							log.Error(err, "Failed to remove protection", "protection", protection.ProtectionArn, "deletionPolicy", deletion)
+							r.Recorder.Eventf(policy, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to remove protection of resource %s: %v", *protection.ResourceArn, err)
+							markFailed(&policy.Status.ReconcileStatus, policy.Generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+							return ctrl.Result{}, updateStatus(ctx, r.Client, original, policy, err)
+						}
+						recordRemoved(r.Recorder, policy, *protection.ResourceArn, deletion)
 					}
 				}
 			} else {
-				log.Info("Dry-run mode enabled, skipping deletion of protection resources")
+				log.Info("Dry-run mode enabled, skipping deletion of protection resources", "deletionPolicy", deletion)
 			}
 
 			// Remove the finalizer
@@ -80,90 +128,483 @@ func (r *ProtectionPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Req
 				log.Error(err, "Failed to remove finalizer")
 				return ctrl.Result{}, err
 			}
+			metrics.DeletePolicy(policy.Namespace, policy.Name)
 		}
 		return ctrl.Result{}, nil
 	}
 
+	// Protections cannot be created in the account of the controller without an active subscription,
+	// which is checked again after the resync interval
+	if len(policy.Spec.Accounts) == 0 && policy.Spec.Organization == nil {
+		if err := r.Subscription.syncSubscriptionStatus(&policy.Status.ReconcileStatus, policy.Generation); err != nil {
+			log.Info("Skipping reconciliation of protections", "reason", err.Error())
+			markFailed(&policy.Status.ReconcileStatus, policy.Generation, shieldawsv1alpha1.ReasonSubscriptionInactive, err)
+			return ctrl.Result{RequeueAfter: r.Config.PolicyResyncInterval}, updateStatus(ctx, r.Client, original, policy, nil)
+		}
+	}
+
+	// Access the accounts in which the policy manages protections
+	accounts, accountErrs := r.resolveAccounts(ctx, policy)
+
+	// Retry only the protections that failed during the last reconciliation, until the next resync
+	if len(accountErrs) == 0 && r.shouldRetryFailed(policy) {
+		log.Info("Retrying failed protections")
+		r.reconcileProtections(ctx, policy, accounts, owner, nil)
+		return r.finishReconcile(ctx, original, policy, nil)
+	}
+
 	// Find all resources that match the ProtectionPolicy
 	resourcesTypes := []string{}
 	for _, typ := range policy.Spec.MatchResourceTypes {
 		resourcesTypes = append(resourcesTypes, string(typ))
 	}
 
-	resources, err := r.Discovery.Discover(ctx, &aws.DiscoveryRequest{
-		ResourceTypes: resourcesTypes,
-		Regions:       policy.Spec.MatchRegions,
-	})
-	if err != nil {
-		log.Error(err, "Failed to discover resources")
-		return ctrl.Result{}, err
+	// Resources protected during the last reconciliation are not reported as newly protected
+	protected := map[string]bool{}
+	for _, protection := range policy.Status.Protections {
+		if protection.State == shieldawsv1alpha1.ProtectionStateActive {
+			protected[protection.ResourceArn] = true
+		}
 	}
 
-	log.Info("Discovered resources", "count", len(resources.Resources))
-	log.V(1).Info("Discovered resources", "resources", resources.Resources)
+	// Every selected resource is pending until its protection has been reconciled
+	policy.Status.Protections = []shieldawsv1alpha1.ProtectionStatus{}
+	policy.Status.ExcludedCount = 0
+	policy.Status.UnprotectableResources = nil
+
+	// Resources of the accounts and types that could not be discovered are missing, so the discovery is incomplete
+	discoveryErrs := accountErrs
+	selected := map[string][]aws.DiscoveredResource{}
+	discovered := map[[2]string]int{}
+	for _, account := range accounts {
+		resources, err := account.Discovery.Discover(ctx, &aws.DiscoveryRequest{
+			ResourceTypes:  resourcesTypes,
+			Regions:        policy.Spec.MatchRegions,
+			ExcludeRegions: policy.Spec.ExcludeRegions,
+			EIPFilter:      filter.ElasticIPs(policy.Spec.ElasticIPs),
+			WithTags:       filter.SelectsTags(&policy.Spec),
+		})
+		if err == nil {
+			err = errors.Join(resources.Errors...)
+		}
+		if err != nil {
+			log.Error(err, "Failed to discover some resources", "accountId", account.AccountId)
+			err = fmt.Errorf("account %s: %w", account.AccountId, err)
+			setAccountError(policy, account.AccountId, err)
+			discoveryErrs = append(discoveryErrs, err)
+		}
+		if resources == nil {
+			continue
+		}
+
+		log.Info("Discovered resources", "accountId", account.AccountId, "count", len(resources.Resources))
+		for _, resource := range resources.Resources {
+			discovered[[2]string{resource.Type, resourceRegion(resource.Arn)}]++
+		}
+
+		// Keep only the resources selected by the policy so that excluded resources are neither protected nor kept protected
+		matched, excluded, err := filter.Resources(&policy.Spec, resources.Resources)
+		if err != nil {
+			log.Error(err, "Failed to select resources")
+			markFailed(&policy.Status.ReconcileStatus, policy.Generation, shieldawsv1alpha1.ReasonInvalidSelector, err)
+			return ctrl.Result{}, updateStatus(ctx, r.Client, original, policy, err)
+		}
+		policy.Status.ExcludedCount += int32(excluded)
+
+		// Report the selected resources that cannot be protected, as they would otherwise silently be left unprotected
+		unprotectable, err := filter.UnprotectableResources(&policy.Spec, resources.Unprotectable)
+		if err != nil {
+			log.Error(err, "Failed to select resources")
+			markFailed(&policy.Status.ReconcileStatus, policy.Generation, shieldawsv1alpha1.ReasonInvalidSelector, err)
+			return ctrl.Result{}, updateStatus(ctx, r.Client, original, policy, err)
+		}
+		for _, resource := range unprotectable {
+			policy.Status.UnprotectableResources = append(policy.Status.UnprotectableResources, shieldawsv1alpha1.UnprotectableResourceStatus{
+				ResourceArn: resource.Arn,
+				Name:        resource.Name,
+				AccountId:   account.AccountId,
+				Reason:      resource.Reason,
+			})
+		}
+
+		log.Info("Selected resources", "accountId", account.AccountId, "count", len(matched), "excluded", excluded, "unprotectable", len(unprotectable))
+		log.V(1).Info("Selected resources", "accountId", account.AccountId, "resources", matched)
+
+		selected[account.AccountId] = matched
+		for _, resource := range matched {
+			policy.Status.Protections = append(policy.Status.Protections, shieldawsv1alpha1.ProtectionStatus{
+				State:       shieldawsv1alpha1.ProtectionStatePending,
+				Name:        resource.Name,
+				AccountId:   account.AccountId,
+				ResourceArn: resource.Arn,
+			})
+		}
+	}
+
+	discoveryErr := errors.Join(discoveryErrs...)
+	if discoveryErr != nil {
+		r.Recorder.Eventf(policy, corev1.EventTypeWarning, EventReasonDiscoveryFailed, "Failed to discover some resources: %v", discoveryErr)
+		setCondition(&policy.Status.ReconcileStatus, policy.Generation, shieldawsv1alpha1.ConditionTypeDiscoveryFailed, metav1.ConditionTrue, shieldawsv1alpha1.ReasonDiscoveryError, discoveryErr.Error())
+	} else {
+		setCondition(&policy.Status.ReconcileStatus, policy.Generation, shieldawsv1alpha1.ConditionTypeDiscoveryFailed, metav1.ConditionFalse, shieldawsv1alpha1.ReasonSucceeded, "")
+	}
+
+	now := metav1.Now()
+	policy.Status.LastDiscoveryTime = &now
+
+	metrics.DiscoveredResources.DeletePartialMatch(prometheus.Labels{"namespace": policy.Namespace, "policy": policy.Name})
+	for key, count := range discovered {
+		metrics.DiscoveredResources.WithLabelValues(policy.Namespace, policy.Name, key[0], key[1]).Set(float64(count))
+	}
 
 	if r.Config.DryRun {
 		log.Info("Dry-run mode enabled, skipping creation or update of protection resources")
-		return ctrl.Result{}, nil
+		summarizeAccounts(policy)
+		recordPolicyMetrics(policy)
+		markDryRun(&policy.Status.ReconcileStatus, policy.Generation)
+		return ctrl.Result{}, updateStatus(ctx, r.Client, original, policy, nil)
 	}
 
 	// Create or update protection resources in AWS and update status
-	policy.Status.Protections = []shieldawsv1alpha1.ProtectionStatus{}
-	for _, resource := range resources.Resources {
-		protectionArn, err := r.ShieldManager.CreateOrUpdateProtection(ctx, resource.Name, resource.Arn)
+	r.reconcileProtections(ctx, policy, accounts, owner, protected)
+
+	// Delete protections owned by this policy that no longer match it
+	var pruneErrs []error
+	for _, account := range accounts {
+		// Pruning an incomplete discovery would delete the protections of resources that still exist
+		if accountError(policy, account.AccountId) != "" {
+			log.Info("Skipping pruning of protections because discovery was incomplete", "accountId", account.AccountId)
+			continue
+		}
+
+		managed, err := account.ShieldManager.ListOwnedProtections(ctx, owner)
 		if err != nil {
-			log.Error(err, "Failed to create protection", "resource", resource.Arn)
-			return ctrl.Result{}, err
+			log.Error(err, "Failed to list managed protections", "accountId", account.AccountId)
+			pruneErrs = append(pruneErrs, fmt.Errorf("account %s: %w", account.AccountId, err))
+			continue
 		}
 
-		policy.Status.Protections = append(policy.Status.Protections, shieldawsv1alpha1.ProtectionStatus{
-			State:         shieldawsv1alpha1.ProtectionStateActive,
-			ProtectionArn: protectionArn,
-			ResourceArn:   resource.Arn,
-		})
+		for _, protection := range managed {
+			found := false
+			for _, resource := range selected[account.AccountId] {
+				if *protection.ResourceArn == resource.Arn {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				log.Info("Deleting protection that no longer matches policy", "protectionArn", protection.ProtectionArn)
+				err := account.ShieldManager.DeleteProtection(ctx, *protection.ProtectionArn)
+				if err != nil {
+					log.Error(err, "Failed to delete protection", "protectionArn", protection.ProtectionArn)
+					r.Recorder.Eventf(policy, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to prune protection of resource %s: %v", *protection.ResourceArn, err)
+					pruneErrs = append(pruneErrs, err)
+					continue
+				}
+				metrics.PrunedProtections.WithLabelValues(policy.Namespace, policy.Name).Inc()
+				r.Recorder.Eventf(policy, corev1.EventTypeNormal, EventReasonProtectionPruned, "Deleted protection %s of resource %s that no longer matches the policy", *protection.ProtectionArn, *protection.ResourceArn)
+			}
+		}
 	}
 
-	// Delete managed protections that no longer match the policy
-	managed, err := r.ShieldManager.ListOwnedProtections(ctx)
+	return r.finishReconcile(ctx, original, policy, errors.Join(append(discoveryErrs, pruneErrs...)...))
+}
+
+// resolveAccounts returns the accounts in which the policy manages protections and records them in the status.
+// An account that cannot be accessed is recorded with its error and skipped.
+func (r *ProtectionPolicyReconciler) resolveAccounts(ctx context.Context, policy *shieldawsv1alpha1.ProtectionPolicy) ([]*aws.Account, []error) {
+	log := log.FromContext(ctx)
+
+	var accounts []*aws.Account
+	var errs []error
+
+	// The explicitly listed accounts are still accessed when the organization accounts cannot be listed
+	targets, err := r.accountTargets(ctx, policy)
 	if err != nil {
-		log.Error(err, "Failed to list managed protections")
-		return ctrl.Result{}, err
+		log.Error(err, "Failed to list organization accounts")
+		errs = append(errs, err)
 	}
 
-	for _, protection := range managed {
-		found := false
-		for _, resource := range resources.Resources {
-			if *protection.ResourceArn == resource.Arn {
-				found = true
-				break
+	policy.Status.Accounts = []shieldawsv1alpha1.AccountStatus{}
+	for _, target := range targets {
+		status := shieldawsv1alpha1.AccountStatus{AccountId: roleAccountId(target.RoleArn), RoleArn: target.RoleArn}
+
+		account, err := r.Accounts.Account(ctx, target)
+		if err != nil {
+			log.Error(err, "Failed to access account", "roleArn", target.RoleArn)
+			status.Error = err.Error()
+			policy.Status.Accounts = append(policy.Status.Accounts, status)
+			errs = append(errs, err)
+			continue
+		}
+
+		status.AccountId = account.AccountId
+		policy.Status.Accounts = append(policy.Status.Accounts, status)
+		accounts = append(accounts, account)
+	}
+
+	return accounts, errs
+}
+
+// reconcileProtections creates or updates the protection of every resource of the policy that is not
+// active yet. A failure is recorded on the resource and does not stop the reconciliation of the others.
+// Resources that are not in protected are reported as newly protected.
+func (r *ProtectionPolicyReconciler) reconcileProtections(ctx context.Context, policy *shieldawsv1alpha1.ProtectionPolicy, accounts []*aws.Account, owner aws.Owner, protected map[string]bool) {
+	log := log.FromContext(ctx)
+
+	byId := map[string]*aws.Account{}
+	for _, account := range accounts {
+		byId[account.AccountId] = account
+	}
+
+	for i := range policy.Status.Protections {
+		protection := &policy.Status.Protections[i]
+		if protection.State == shieldawsv1alpha1.ProtectionStateActive {
+			continue
+		}
+
+		// Leave the remaining resources pending when the reconciliation is cancelled
+		if ctx.Err() != nil {
+			return
+		}
+
+		account, ok := byId[protection.AccountId]
+		if !ok {
+			protection.State = shieldawsv1alpha1.ProtectionStateFailed
+			protection.Reason = shieldawsv1alpha1.ReasonAccountError
+			protection.Error = fmt.Sprintf("account %s is not accessible", protection.AccountId)
+			continue
+		}
+
+		result, err := account.ShieldManager.CreateOrUpdateProtection(ctx, aws.ProtectionRequest{
+			Name:                              protection.Name,
+			ResourceArn:                       protection.ResourceArn,
+			ApplicationLayerAutomaticResponse: toAWSApplicationLayerAutomaticResponse(policy.Spec.ApplicationLayerAutomaticResponse),
+			AdoptionPolicy:                    string(policy.Spec.AdoptionPolicy),
+		}, owner)
+		if err != nil {
+			log.Error(err, "Failed to create protection", "resource", protection.ResourceArn)
+			r.Recorder.Eventf(policy, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to protect resource %s: %v", protection.ResourceArn, err)
+			protection.State = shieldawsv1alpha1.ProtectionStateFailed
+			protection.Reason = shieldawsv1alpha1.ReasonShieldAPIError
+			if errors.Is(err, aws.ErrInvalidResourceArn) {
+				protection.Reason = shieldawsv1alpha1.ReasonInvalidResourceArn
 			}
+			protection.Error = err.Error()
+			continue
 		}
 
-		if !found {
-			log.Info("Deleting protection that no longer matches policy", "protectionArn", protection.ProtectionArn)
-			err := r.ShieldManager.DeleteProtection(ctx, *protection.ProtectionArn)
-			if err != nil {
-				log.Error(err, "Failed to delete protection", "protectionArn", protection.ProtectionArn)
-				return ctrl.Result{}, err
+		if !protected[protection.ResourceArn] {
+			recordProtected(r.Recorder, policy, protection.ResourceArn, result)
+		}
+
+		protection.State = shieldawsv1alpha1.ProtectionStateActive
+		protection.ProtectionArn = result.ProtectionArn
+		protection.Origin = shieldawsv1alpha1.ProtectionOrigin(result.Origin)
+		protection.ApplicationLayerAutomaticResponse = fromAWSApplicationLayerAutomaticResponse(result.ApplicationLayerAutomaticResponse)
+		protection.Reason = ""
+		protection.Error = ""
+	}
+}
+
+// finishReconcile records the outcome of the reconciliation in the status. The policy is requeued with
+// backoff while some of its protections failed in a way that retrying can fix or the reconciliation hit
+// an error, and after the resync interval otherwise.
+func (r *ProtectionPolicyReconciler) finishReconcile(ctx context.Context, original, policy *shieldawsv1alpha1.ProtectionPolicy, reconcileErr error) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	summarizeAccounts(policy)
+	recordPolicyMetrics(policy)
+
+	var failed, retryable []string
+	for _, protection := range policy.Status.Protections {
+		if protection.State != shieldawsv1alpha1.ProtectionStateActive {
+			failed = append(failed, protection.ResourceArn)
+			if protection.Reason != shieldawsv1alpha1.ReasonInvalidResourceArn {
+				retryable = append(retryable, protection.ResourceArn)
 			}
 		}
 	}
-	err = r.Status().Update(ctx, policy)
-	if err != nil {
-		log.Error(err, "Failed to update ProtectionPolicy status")
+
+	if len(failed) == 0 && reconcileErr == nil {
+		markSynced(&policy.Status.ReconcileStatus, policy.Generation)
+		if err := updateStatus(ctx, r.Client, original, policy, nil); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// Requeue after the configured resync interval
+		log.V(1).Info("Requeueing after resync interval", "interval", r.Config.PolicyResyncInterval)
+		return ctrl.Result{
+			RequeueAfter: r.Config.PolicyResyncInterval,
+		}, nil
+	}
+
+	message := fmt.Sprintf("%d of %d protections are not active", len(failed), len(policy.Status.Protections))
+	if reconcileErr != nil {
+		message = fmt.Sprintf("%s: %s", message, reconcileErr)
+	}
+	markDegraded(&policy.Status.ReconcileStatus, policy.Generation, shieldawsv1alpha1.ReasonProtectionsFailed, message)
+
+	if err := updateStatus(ctx, r.Client, original, policy, nil); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	// Requeue after the configured resync interval
-	log.V(1).Info("Requeueing after resync interval", "interval", r.Config.PolicyResyncInterval)
-	return ctrl.Result{
-		RequeueAfter: r.Config.PolicyResyncInterval,
-	}, nil
+	// Invalid resources are only reported, as retrying cannot help until they change
+	if len(retryable) == 0 && reconcileErr == nil {
+		log.Info("Requeueing after resync interval", "failed", failed, "interval", r.Config.PolicyResyncInterval)
+		return ctrl.Result{RequeueAfter: r.Config.PolicyResyncInterval}, nil
+	}
+
+	log.Info("Requeueing with backoff", "failed", failed)
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// shouldRetryFailed reports whether the next reconciliation only needs to retry the protections that are not
+// active, because the policy is unchanged since its last complete discovery and the resync interval has not elapsed
+func (r *ProtectionPolicyReconciler) shouldRetryFailed(policy *shieldawsv1alpha1.ProtectionPolicy) bool {
+	status := policy.Status
+	if r.Config.DryRun || status.ObservedGeneration != policy.Generation || status.LastDiscoveryTime == nil {
+		return false
+	}
+
+	if time.Since(status.LastDiscoveryTime.Time) >= r.Config.PolicyResyncInterval {
+		return false
+	}
+
+	if meta.IsStatusConditionTrue(status.Conditions, shieldawsv1alpha1.ConditionTypeDiscoveryFailed) {
+		return false
+	}
+
+	return slices.ContainsFunc(status.Protections, func(protection shieldawsv1alpha1.ProtectionStatus) bool {
+		return protection.State != shieldawsv1alpha1.ProtectionStateActive
+	})
+}
+
+// accountTargets returns the accounts in which the policy manages protections, which is the account of the
+// controller's own credentials unless the policy lists accounts or targets organizational units. An account
+// targeted more than once is only accessed through its first role.
+func (r *ProtectionPolicyReconciler) accountTargets(ctx context.Context, policy *shieldawsv1alpha1.ProtectionPolicy) ([]aws.AccountTarget, error) {
+	organization := policy.Spec.Organization
+	if len(policy.Spec.Accounts) == 0 && organization == nil {
+		return []aws.AccountTarget{{}}, nil
+	}
+
+	var targets []aws.AccountTarget
+	seen := map[string]bool{}
+	add := func(target aws.AccountTarget) {
+		accountId := roleAccountId(target.RoleArn)
+		if !seen[accountId] {
+			seen[accountId] = true
+			targets = append(targets, target)
+		}
+	}
+
+	for _, account := range policy.Spec.Accounts {
+		add(aws.AccountTarget{
+			RoleArn:    account.RoleArn,
+			ExternalId: account.ExternalId,
+		})
+	}
+
+	if organization == nil {
+		return targets, nil
+	}
+
+	accountIds, err := r.Accounts.ListOrganizationAccounts(ctx, organization.OrganizationalUnitIds)
+	if err != nil {
+		return targets, err
+	}
+
+	for _, accountId := range accountIds {
+		if slices.Contains(organization.ExcludeAccountIds, accountId) {
+			continue
+		}
+		add(aws.AccountTarget{
+			RoleArn:    r.Accounts.RoleArn(accountId, organization.RoleName),
+			ExternalId: organization.ExternalId,
+		})
+	}
+
+	return targets, nil
+}
+
+// roleAccountId returns the ID of the account of a role, or an empty string for an invalid role ARN
+func roleAccountId(roleArn string) string {
+	parsed, err := arn.Parse(roleArn)
+	if err != nil {
+		return ""
+	}
+	return parsed.AccountID
+}
+
+// summarizeAccounts records the number of selected and protected resources of each account of the policy
+func summarizeAccounts(policy *shieldawsv1alpha1.ProtectionPolicy) {
+	for i := range policy.Status.Accounts {
+		account := &policy.Status.Accounts[i]
+		account.DiscoveredCount = 0
+		account.ProtectedCount = 0
+
+		for _, protection := range policy.Status.Protections {
+			if protection.AccountId != account.AccountId {
+				continue
+			}
+			account.DiscoveredCount++
+			if protection.State == shieldawsv1alpha1.ProtectionStateActive {
+				account.ProtectedCount++
+			}
+		}
+	}
+}
+
+// recordPolicyMetrics exports the number of protections of the policy per state, and of its unprotected resources
+func recordPolicyMetrics(policy *shieldawsv1alpha1.ProtectionPolicy) {
+	states := map[shieldawsv1alpha1.ProtectionState]int{
+		shieldawsv1alpha1.ProtectionStateActive:  0,
+		shieldawsv1alpha1.ProtectionStatePending: 0,
+		shieldawsv1alpha1.ProtectionStateFailed:  0,
+	}
+	for _, protection := range policy.Status.Protections {
+		states[protection.State]++
+	}
+
+	for state, count := range states {
+		metrics.PolicyProtections.WithLabelValues(policy.Namespace, policy.Name, string(state)).Set(float64(count))
+	}
+	metrics.UnprotectedResources.WithLabelValues(policy.Namespace, policy.Name).Set(float64(len(policy.Status.Protections) - states[shieldawsv1alpha1.ProtectionStateActive]))
+}
+
+// resourceRegion returns the region of a resource, or "global" for the resources of global services
+func resourceRegion(resourceArn string) string {
+	parsed, err := arn.Parse(resourceArn)
+	if err != nil || parsed.Region == "" {
+		return "global"
+	}
+	return parsed.Region
+}
+
+// setAccountError records the error that prevented the resources of an account from being discovered
+func setAccountError(policy *shieldawsv1alpha1.ProtectionPolicy, accountId string, err error) {
+	for i := range policy.Status.Accounts {
+		if policy.Status.Accounts[i].AccountId == accountId {
+			policy.Status.Accounts[i].Error = err.Error()
+		}
+	}
+}
+
+// accountError returns the error recorded for an account, if any
+func accountError(policy *shieldawsv1alpha1.ProtectionPolicy, accountId string) string {
+	for _, account := range policy.Status.Accounts {
+		if account.AccountId == accountId {
+			return account.Error
+		}
+	}
+	return ""
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ProtectionPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&shieldawsv1alpha1.ProtectionPolicy{}).
+		For(&shieldawsv1alpha1.ProtectionPolicy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Complete(r)
 }
@@ -2,16 +2,24 @@ package controller
 
 import (
 	"context"
+	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
 )
 
 var _ = Describe("ProtectionPolicy Controller", func() {
@@ -66,3 +74,61 @@ var _ = Describe("ProtectionPolicy Controller", func() {
 		})
 	})
 })
+
+func TestProtectionPolicyReconciler_FinishReconcile(t *testing.T) {
+	invalid := shieldawsv1alpha1.ProtectionStatus{
+		ResourceArn: "invalid-arn",
+		State:       shieldawsv1alpha1.ProtectionStateFailed,
+		Reason:      shieldawsv1alpha1.ReasonInvalidResourceArn,
+	}
+	throttled := shieldawsv1alpha1.ProtectionStatus{
+		ResourceArn: "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/1234567890abcdef",
+		State:       shieldawsv1alpha1.ProtectionStateFailed,
+		Reason:      shieldawsv1alpha1.ReasonShieldAPIError,
+	}
+
+	tests := []struct {
+		name        string
+		protections []shieldawsv1alpha1.ProtectionStatus
+		expected    ctrl.Result
+	}{
+		{
+			name:        "Retry failures with backoff",
+			protections: []shieldawsv1alpha1.ProtectionStatus{invalid, throttled},
+			expected:    ctrl.Result{Requeue: true},
+		},
+		{
+			name:        "Only report invalid resources",
+			protections: []shieldawsv1alpha1.ProtectionStatus{invalid},
+			expected:    ctrl.Result{RequeueAfter: time.Hour},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			scheme := runtime.NewScheme()
+			assert.NoError(t, shieldawsv1alpha1.AddToScheme(scheme))
+
+			policy := &shieldawsv1alpha1.ProtectionPolicy{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(policy).
+				WithStatusSubresource(policy).
+				Build()
+			reconciler := &ProtectionPolicyReconciler{
+				Client: c,
+				Scheme: scheme,
+				Config: &config.Config{PolicyResyncInterval: time.Hour},
+			}
+
+			original := policy.DeepCopy()
+			policy.Status.Protections = tt.protections
+			result, err := reconciler.finishReconcile(ctx, original, policy, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, shieldawsv1alpha1.ReasonProtectionsFailed, policy.Status.Conditions[0].Reason)
+		})
+	}
+}
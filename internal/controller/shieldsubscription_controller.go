@@ -0,0 +1,210 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
+)
+
+// SubscriptionPendingInterval is how soon a subscription that still differs from its spec after the spec was applied
+// is checked again, rather than after the subscription check interval
+var SubscriptionPendingInterval = 30 * time.Second
+
+// ShieldSubscriptionReconciler reconciles a ShieldSubscription object
+type ShieldSubscriptionReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	Config        *config.Config
+	ShieldManager aws.ShieldManager
+	Recorder      record.EventRecorder
+	Subscription  *SubscriptionMonitor
+}
+
+//+kubebuilder:rbac:groups=shield.aws.geode.io,resources=shieldsubscriptions,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=shield.aws.geode.io,resources=shieldsubscriptions/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *ShieldSubscriptionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	// Fetch the ShieldSubscription instance
+	shieldSubscription := &shieldawsv1alpha1.ShieldSubscription{}
+	if err := r.Get(ctx, req.NamespacedName, shieldSubscription); err != nil {
+		if apierrors.IsNotFound(err) {
+			// ShieldSubscription resource not found, no need to requeue
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object, requeue the request
+		return ctrl.Result{}, err
+	}
+
+	original := shieldSubscription.DeepCopy()
+
+	status := &shieldSubscription.Status.ReconcileStatus
+	generation := shieldSubscription.Generation
+
+	subscription, err := r.ShieldManager.GetSubscription(ctx)
+	if err != nil {
+		log.Error(err, "Failed to get subscription")
+		markFailed(status, generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+		return ctrl.Result{}, updateStatus(ctx, r.Client, original, shieldSubscription, err)
+	}
+
+	changed := false
+	if shieldSubscription.Spec.ManagementPolicy == shieldawsv1alpha1.SubscriptionManagementPolicyManage && !r.Config.DryRun {
+		changed, err = r.apply(ctx, shieldSubscription, subscription)
+		if err != nil {
+			log.Error(err, "Failed to apply subscription")
+			r.Recorder.Eventf(shieldSubscription, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to apply subscription: %v", err)
+			markFailed(status, generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+			return ctrl.Result{}, updateStatus(ctx, r.Client, original, shieldSubscription, err)
+		}
+	}
+
+	if changed {
+		// Read the subscription back so that the status shows its new periods and limits
+		subscription, err = r.ShieldManager.GetSubscription(ctx)
+		if err != nil {
+			log.Error(err, "Failed to get subscription")
+			markFailed(status, generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+			return ctrl.Result{}, updateStatus(ctx, r.Client, original, shieldSubscription, err)
+		}
+
+		// Let the other controllers know about the change without waiting for the next check
+		if r.Subscription != nil {
+			r.Subscription.Refresh(ctx)
+		}
+	}
+
+	setSubscriptionStatus(&shieldSubscription.Status, subscription)
+	setSubscriptionExpiring(status, generation, subscription, r.Config.SubscriptionExpiryWarning)
+
+	// Requeue after the subscription check interval so that the status follows changes made outside of the controller
+	requeueAfter := r.Config.SubscriptionCheckInterval
+
+	switch drift := subscriptionDrift(shieldSubscription, subscription); {
+	case drift == "":
+		markSynced(status, generation)
+	case shieldSubscription.Spec.ManagementPolicy != shieldawsv1alpha1.SubscriptionManagementPolicyManage:
+		log.Info("Subscription differs from spec, management policy only allows observing it", "drift", drift)
+		markFailed(status, generation, shieldawsv1alpha1.ReasonObserveOnly, fmt.Errorf("%s, set managementPolicy to Manage to apply the spec", drift))
+	case r.Config.DryRun:
+		log.Info("Dry-run: skipping creation or update of subscription", "drift", drift)
+		markDryRun(status, generation)
+	default:
+		// The changes just applied may take a moment to be reflected, so check again soon
+		log.Info("Subscription still differs from spec after applying it", "drift", drift)
+		markFailed(status, generation, shieldawsv1alpha1.ReasonChangesPending, fmt.Errorf("%s after applying the spec", drift))
+		requeueAfter = SubscriptionPendingInterval
+	}
+
+	if err := updateStatus(ctx, r.Client, original, shieldSubscription, nil); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.V(1).Info("Requeueing subscription", "interval", requeueAfter)
+	return ctrl.Result{
+		RequeueAfter: requeueAfter,
+	}, nil
+}
+
+// apply creates the subscription when the account has none and updates its auto-renewal to match the spec,
+// and returns whether the subscription was changed
+func (r *ShieldSubscriptionReconciler) apply(ctx context.Context, shieldSubscription *shieldawsv1alpha1.ShieldSubscription, subscription *aws.Subscription) (bool, error) {
+	changed := false
+
+	if !subscription.Active {
+		if err := r.ShieldManager.CreateSubscription(ctx); err != nil {
+			return changed, err
+		}
+		r.Recorder.Event(shieldSubscription, corev1.EventTypeNormal, EventReasonSubscriptionCreated, "Created AWS Shield Advanced subscription")
+		changed = true
+
+		// A new subscription is renewed automatically unless the spec says otherwise
+		subscription = &aws.Subscription{Active: true, AutoRenew: true}
+	}
+
+	autoRenew := shieldSubscription.Spec.AutoRenew
+	if autoRenew != nil && *autoRenew != subscription.AutoRenew {
+		if err := r.ShieldManager.UpdateSubscription(ctx, *autoRenew); err != nil {
+			return changed, err
+		}
+		r.Recorder.Eventf(shieldSubscription, corev1.EventTypeNormal, EventReasonSubscriptionUpdated, "Set auto-renewal of AWS Shield Advanced subscription to %t", *autoRenew)
+		changed = true
+	}
+
+	return changed, nil
+}
+
+// subscriptionDrift describes how the subscription differs from the spec, or returns an empty string when it matches
+func subscriptionDrift(shieldSubscription *shieldawsv1alpha1.ShieldSubscription, subscription *aws.Subscription) string {
+	if !subscription.Active {
+		return "AWS Shield Advanced subscription is not active"
+	}
+
+	autoRenew := shieldSubscription.Spec.AutoRenew
+	if autoRenew != nil && *autoRenew != subscription.AutoRenew {
+		return fmt.Sprintf("AWS Shield Advanced subscription auto-renewal is %t instead of %t", subscription.AutoRenew, *autoRenew)
+	}
+
+	return ""
+}
+
+// setSubscriptionStatus records the subscription on the status
+func setSubscriptionStatus(status *shieldawsv1alpha1.ShieldSubscriptionStatus, subscription *aws.Subscription) {
+	status.State = shieldawsv1alpha1.SubscriptionStateInactive
+	status.StartTime = nil
+	status.EndTime = nil
+	status.TimeCommitmentInSeconds = 0
+	status.AutoRenew = nil
+	status.ProtectionLimits = nil
+	status.MaxProtectionGroups = 0
+
+	if !subscription.Active {
+		return
+	}
+
+	status.State = shieldawsv1alpha1.SubscriptionStateActive
+	if subscription.StartTime != nil {
+		startTime := metav1.NewTime(*subscription.StartTime)
+		status.StartTime = &startTime
+	}
+	if subscription.EndTime != nil {
+		endTime := metav1.NewTime(*subscription.EndTime)
+		status.EndTime = &endTime
+	}
+	status.TimeCommitmentInSeconds = int64(subscription.TimeCommitment.Seconds())
+	autoRenew := subscription.AutoRenew
+	status.AutoRenew = &autoRenew
+	for _, limit := range subscription.ProtectionLimits {
+		status.ProtectionLimits = append(status.ProtectionLimits, shieldawsv1alpha1.SubscriptionLimit{
+			Type: limit.Type,
+			Max:  limit.Max,
+		})
+	}
+	status.MaxProtectionGroups = subscription.MaxProtectionGroups
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ShieldSubscriptionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&shieldawsv1alpha1.ShieldSubscription{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,180 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
+)
+
+// fakeSubscriptionManager records the calls changing a subscription that never becomes active
+type fakeSubscriptionManager struct {
+	aws.ShieldManager
+
+	calls []string
+}
+
+func (m *fakeSubscriptionManager) GetSubscription(ctx context.Context) (*aws.Subscription, error) {
+	return &aws.Subscription{Active: false}, nil
+}
+
+func (m *fakeSubscriptionManager) CreateSubscription(ctx context.Context) error {
+	m.calls = append(m.calls, "CreateSubscription()")
+	return nil
+}
+
+func TestShieldSubscriptionReconciler_Reconcile(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       shieldawsv1alpha1.SubscriptionManagementPolicy
+		dryRun       bool
+		calls        []string
+		reason       string
+		requeueAfter time.Duration
+	}{
+		{
+			name:         "Observe",
+			policy:       shieldawsv1alpha1.SubscriptionManagementPolicyObserve,
+			reason:       shieldawsv1alpha1.ReasonObserveOnly,
+			requeueAfter: time.Hour,
+		},
+		{
+			name:         "Manage in dry-run",
+			policy:       shieldawsv1alpha1.SubscriptionManagementPolicyManage,
+			dryRun:       true,
+			reason:       shieldawsv1alpha1.ReasonDryRun,
+			requeueAfter: time.Hour,
+		},
+		{
+			name:         "Manage with changes pending",
+			policy:       shieldawsv1alpha1.SubscriptionManagementPolicyManage,
+			calls:        []string{"CreateSubscription()"},
+			reason:       shieldawsv1alpha1.ReasonChangesPending,
+			requeueAfter: SubscriptionPendingInterval,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			scheme := runtime.NewScheme()
+			assert.NoError(t, shieldawsv1alpha1.AddToScheme(scheme))
+
+			shieldSubscription := &shieldawsv1alpha1.ShieldSubscription{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       shieldawsv1alpha1.ShieldSubscriptionSpec{ManagementPolicy: tt.policy},
+			}
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(shieldSubscription).
+				WithStatusSubresource(shieldSubscription).
+				Build()
+			manager := &fakeSubscriptionManager{}
+			reconciler := &ShieldSubscriptionReconciler{
+				Client:        c,
+				Scheme:        scheme,
+				Config:        &config.Config{DryRun: tt.dryRun, SubscriptionCheckInterval: time.Hour},
+				ShieldManager: manager,
+				Recorder:      record.NewFakeRecorder(10),
+			}
+
+			result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(shieldSubscription)})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.requeueAfter, result.RequeueAfter)
+			assert.Equal(t, tt.calls, manager.calls)
+
+			assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(shieldSubscription), shieldSubscription))
+			synced := meta.FindStatusCondition(shieldSubscription.Status.Conditions, shieldawsv1alpha1.ConditionTypeSynced)
+			assert.Equal(t, tt.reason, synced.Reason)
+		})
+	}
+}
+
+func TestSubscriptionDrift(t *testing.T) {
+	enabled, disabled := true, false
+
+	tests := []struct {
+		name         string
+		autoRenew    *bool
+		subscription *aws.Subscription
+		drift        bool
+	}{
+		{
+			name:         "Inactive",
+			subscription: &aws.Subscription{Active: false},
+			drift:        true,
+		},
+		{
+			name:         "Auto-renewal unmanaged",
+			subscription: &aws.Subscription{Active: true, AutoRenew: false},
+		},
+		{
+			name:         "Auto-renewal matches",
+			autoRenew:    &enabled,
+			subscription: &aws.Subscription{Active: true, AutoRenew: true},
+		},
+		{
+			name:         "Auto-renewal differs",
+			autoRenew:    &disabled,
+			subscription: &aws.Subscription{Active: true, AutoRenew: true},
+			drift:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shieldSubscription := &shieldawsv1alpha1.ShieldSubscription{
+				Spec: shieldawsv1alpha1.ShieldSubscriptionSpec{AutoRenew: tt.autoRenew},
+			}
+
+			drift := subscriptionDrift(shieldSubscription, tt.subscription)
+			assert.Equal(t, tt.drift, drift != "", drift)
+		})
+	}
+}
+
+func TestSetSubscriptionStatus(t *testing.T) {
+	startTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	status := &shieldawsv1alpha1.ShieldSubscriptionStatus{}
+	setSubscriptionStatus(status, &aws.Subscription{
+		Active:              true,
+		StartTime:           &startTime,
+		EndTime:             &endTime,
+		AutoRenew:           true,
+		TimeCommitment:      365 * 24 * time.Hour,
+		ProtectionLimits:    []aws.Limit{{Type: "ELASTIC_IP_ADDRESS", Max: 100}},
+		MaxProtectionGroups: 20,
+	})
+
+	assert.Equal(t, shieldawsv1alpha1.SubscriptionStateActive, status.State)
+	assert.True(t, status.StartTime.Time.Equal(startTime))
+	assert.True(t, status.EndTime.Time.Equal(endTime))
+	assert.Equal(t, int64(31536000), status.TimeCommitmentInSeconds)
+	assert.True(t, *status.AutoRenew)
+	assert.Equal(t, []shieldawsv1alpha1.SubscriptionLimit{{Type: "ELASTIC_IP_ADDRESS", Max: 100}}, status.ProtectionLimits)
+	assert.Equal(t, int64(20), status.MaxProtectionGroups)
+
+	// A cancelled subscription clears the details of the previous one
+	setSubscriptionStatus(status, &aws.Subscription{Active: false})
+	assert.Equal(t, shieldawsv1alpha1.SubscriptionStateInactive, status.State)
+	assert.Nil(t, status.EndTime)
+	assert.Nil(t, status.AutoRenew)
+	assert.Empty(t, status.ProtectionLimits)
+}
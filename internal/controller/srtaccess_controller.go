@@ -0,0 +1,220 @@
+package controller
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
+)
+
+// SRTAccessReconciler reconciles a SRTAccess object
+type SRTAccessReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	Config        *config.Config
+	ShieldManager aws.ShieldManager
+	Recorder      record.EventRecorder
+	Subscription  *SubscriptionMonitor
+}
+
+//+kubebuilder:rbac:groups=shield.aws.geode.io,resources=srtaccesses,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=shield.aws.geode.io,resources=srtaccesses/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=shield.aws.geode.io,resources=srtaccesses/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *SRTAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	// Fetch the SRTAccess instance
+	access := &shieldawsv1alpha1.SRTAccess{}
+	if err := r.Get(ctx, req.NamespacedName, access); err != nil {
+		if apierrors.IsNotFound(err) {
+			// SRTAccess resource not found, no need to requeue
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object, requeue the request
+		return ctrl.Result{}, err
+	}
+
+	original := access.DeepCopy()
+
+	status := &access.Status.ReconcileStatus
+	generation := access.Generation
+
+	// Add the finalizer if it doesn't exist
+	if !controllerutil.ContainsFinalizer(access, FinalizerName) {
+		controllerutil.AddFinalizer(access, FinalizerName)
+		err := r.Update(ctx, access)
+		if err != nil {
+			log.Error(err, "Failed to add finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Check if the SRTAccess instance is marked for deletion
+	if access.GetDeletionTimestamp() != nil {
+		// SRTAccess is marked for deletion
+		if controllerutil.ContainsFinalizer(access, FinalizerName) {
+
+			// Revoke or retain the access of the SRT
+			policy := deletionPolicy(r.Config, access.Spec.DeletionPolicy)
+			if policy == shieldawsv1alpha1.DeletionPolicyDelete && !r.Config.DryRun {
+				current, err := r.ShieldManager.GetDRTAccess(ctx)
+				if err == nil {
+					err = r.apply(ctx, access, &aws.DRTAccess{}, current)
+				}
+				if err != nil {
+					log.Error(err, "Failed to revoke SRT access")
+					r.Recorder.Eventf(access, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to revoke SRT access: %v", err)
+					markFailed(status, generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+					return ctrl.Result{}, updateStatus(ctx, r.Client, original, access, err)
+				}
+			} else {
+				log.Info("Skipping revocation of SRT access", "deletionPolicy", policy, "dryRun", r.Config.DryRun)
+			}
+
+			// Remove the finalizer
+			controllerutil.RemoveFinalizer(access, FinalizerName)
+			err := r.Update(ctx, access)
+			if err != nil {
+				log.Error(err, "Failed to remove finalizer")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// SRT access requires an active subscription, which is checked again after the check interval
+	if err := r.Subscription.syncSubscriptionStatus(status, generation); err != nil {
+		log.Info("Skipping update of SRT access", "reason", err.Error())
+		markFailed(status, generation, shieldawsv1alpha1.ReasonSubscriptionInactive, err)
+		return ctrl.Result{RequeueAfter: r.Config.SubscriptionCheckInterval}, updateStatus(ctx, r.Client, original, access, nil)
+	}
+
+	current, err := r.ShieldManager.GetDRTAccess(ctx)
+	if err != nil {
+		log.Error(err, "Failed to get SRT access")
+		markFailed(status, generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+		return ctrl.Result{}, updateStatus(ctx, r.Client, original, access, err)
+	}
+
+	desired := &aws.DRTAccess{
+		RoleArn:    access.Spec.RoleArn,
+		LogBuckets: access.Spec.LogBuckets,
+	}
+
+	if r.Config.DryRun {
+		if !drtAccessEqual(desired, current) {
+			log.Info("Dry-run: skipping update of SRT access", "roleArn", desired.RoleArn, "logBuckets", desired.LogBuckets)
+			markDryRun(status, generation)
+		} else {
+			markSynced(status, generation)
+		}
+	} else {
+		if err := r.apply(ctx, access, desired, current); err != nil {
+			log.Error(err, "Failed to update SRT access")
+			r.Recorder.Eventf(access, corev1.EventTypeWarning, EventReasonShieldAPIError, "Failed to update SRT access: %v", err)
+			markFailed(status, generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+			return ctrl.Result{}, updateStatus(ctx, r.Client, original, access, err)
+		}
+
+		if !drtAccessEqual(desired, current) {
+			// Read the access back so that the status shows what AWS Shield Advanced applied
+			current, err = r.ShieldManager.GetDRTAccess(ctx)
+			if err != nil {
+				log.Error(err, "Failed to get SRT access")
+				markFailed(status, generation, shieldawsv1alpha1.ReasonShieldAPIError, err)
+				return ctrl.Result{}, updateStatus(ctx, r.Client, original, access, err)
+			}
+		}
+		markSynced(status, generation)
+	}
+
+	access.Status.RoleArn = current.RoleArn
+	access.Status.LogBuckets = current.LogBuckets
+
+	if err := updateStatus(ctx, r.Client, original, access, nil); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Requeue after the subscription check interval so that changes made outside of the controller are corrected
+	log.V(1).Info("Requeueing after subscription check interval", "interval", r.Config.SubscriptionCheckInterval)
+	return ctrl.Result{
+		RequeueAfter: r.Config.SubscriptionCheckInterval,
+	}, nil
+}
+
+// apply changes the current access of the SRT to the desired one. Log buckets are disassociated before and
+// associated after the role changes, as the SRT can only be granted access to log buckets through its role.
+func (r *SRTAccessReconciler) apply(ctx context.Context, access *shieldawsv1alpha1.SRTAccess, desired, current *aws.DRTAccess) error {
+	for _, bucket := range current.LogBuckets {
+		if slices.Contains(desired.LogBuckets, bucket) {
+			continue
+		}
+		if err := r.ShieldManager.DisassociateDRTLogBucket(ctx, bucket); err != nil {
+			return err
+		}
+		r.Recorder.Eventf(access, corev1.EventTypeNormal, EventReasonSRTAccessRevoked, "Revoked SRT access to log bucket %s", bucket)
+	}
+
+	if desired.RoleArn != current.RoleArn {
+		if desired.RoleArn == "" {
+			if err := r.ShieldManager.DisassociateDRTRole(ctx); err != nil {
+				return err
+			}
+			r.Recorder.Eventf(access, corev1.EventTypeNormal, EventReasonSRTAccessRevoked, "Revoked SRT access to role %s", current.RoleArn)
+		} else {
+			if err := r.ShieldManager.AssociateDRTRole(ctx, desired.RoleArn); err != nil {
+				return err
+			}
+			r.Recorder.Eventf(access, corev1.EventTypeNormal, EventReasonSRTAccessGranted, "Granted SRT access to role %s", desired.RoleArn)
+		}
+	}
+
+	for _, bucket := range desired.LogBuckets {
+		if slices.Contains(current.LogBuckets, bucket) {
+			continue
+		}
+		if err := r.ShieldManager.AssociateDRTLogBucket(ctx, bucket); err != nil {
+			return err
+		}
+		r.Recorder.Eventf(access, corev1.EventTypeNormal, EventReasonSRTAccessGranted, "Granted SRT access to log bucket %s", bucket)
+	}
+
+	return nil
+}
+
+// drtAccessEqual reports whether two accesses of the SRT grant the same role and log buckets, in any order
+func drtAccessEqual(a, b *aws.DRTAccess) bool {
+	if a.RoleArn != b.RoleArn || len(a.LogBuckets) != len(b.LogBuckets) {
+		return false
+	}
+	for _, bucket := range a.LogBuckets {
+		if !slices.Contains(b.LogBuckets, bucket) {
+			return false
+		}
+	}
+	return true
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SRTAccessReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&shieldawsv1alpha1.SRTAccess{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
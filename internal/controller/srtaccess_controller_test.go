@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/record"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+)
+
+// fakeDRTManager records the calls changing the access of the SRT
+type fakeDRTManager struct {
+	aws.ShieldManager
+
+	calls []string
+}
+
+func (m *fakeDRTManager) AssociateDRTRole(ctx context.Context, roleArn string) error {
+	m.calls = append(m.calls, "AssociateDRTRole("+roleArn+")")
+	return nil
+}
+
+func (m *fakeDRTManager) DisassociateDRTRole(ctx context.Context) error {
+	m.calls = append(m.calls, "DisassociateDRTRole()")
+	return nil
+}
+
+func (m *fakeDRTManager) AssociateDRTLogBucket(ctx context.Context, bucket string) error {
+	m.calls = append(m.calls, "AssociateDRTLogBucket("+bucket+")")
+	return nil
+}
+
+func (m *fakeDRTManager) DisassociateDRTLogBucket(ctx context.Context, bucket string) error {
+	m.calls = append(m.calls, "DisassociateDRTLogBucket("+bucket+")")
+	return nil
+}
+
+func TestSRTAccessReconciler_Apply(t *testing.T) {
+	const role = "arn:aws:iam::123456789012:role/srt-access"
+	const otherRole = "arn:aws:iam::123456789012:role/other"
+
+	tests := []struct {
+		name    string
+		desired *aws.DRTAccess
+		current *aws.DRTAccess
+		calls   []string
+	}{
+		{
+			name:    "Grant access",
+			desired: &aws.DRTAccess{RoleArn: role, LogBuckets: []string{"a", "b"}},
+			current: &aws.DRTAccess{},
+			calls:   []string{"AssociateDRTRole(" + role + ")", "AssociateDRTLogBucket(a)", "AssociateDRTLogBucket(b)"},
+		},
+		{
+			name:    "In sync",
+			desired: &aws.DRTAccess{RoleArn: role, LogBuckets: []string{"a", "b"}},
+			current: &aws.DRTAccess{RoleArn: role, LogBuckets: []string{"b", "a"}},
+		},
+		{
+			name:    "Replace a bucket and the role",
+			desired: &aws.DRTAccess{RoleArn: role, LogBuckets: []string{"a", "c"}},
+			current: &aws.DRTAccess{RoleArn: otherRole, LogBuckets: []string{"a", "b"}},
+			calls:   []string{"DisassociateDRTLogBucket(b)", "AssociateDRTRole(" + role + ")", "AssociateDRTLogBucket(c)"},
+		},
+		{
+			name:    "Revoke access",
+			desired: &aws.DRTAccess{},
+			current: &aws.DRTAccess{RoleArn: role, LogBuckets: []string{"a"}},
+			calls:   []string{"DisassociateDRTLogBucket(a)", "DisassociateDRTRole()"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := &fakeDRTManager{}
+			reconciler := &SRTAccessReconciler{
+				ShieldManager: manager,
+				Recorder:      record.NewFakeRecorder(10),
+			}
+
+			err := reconciler.apply(context.Background(), &shieldawsv1alpha1.SRTAccess{}, tt.desired, tt.current)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.calls, manager.calls)
+			assert.Equal(t, tt.calls == nil, drtAccessEqual(tt.desired, tt.current))
+		})
+	}
+}
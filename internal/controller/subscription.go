@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/metrics"
+)
+
+// ErrSubscriptionInactive is returned when the account of the controller has no active Shield Advanced subscription
+var ErrSubscriptionInactive = errors.New("AWS Shield Advanced subscription is not active")
+
+// SubscriptionMonitor periodically checks the Shield Advanced subscription of the account of the controller,
+// without which no protection can be created. Its state is reported through the subscription metrics and the
+// status of the reconciled resources, and optionally through a readiness check.
+type SubscriptionMonitor struct {
+	ShieldManager aws.ShieldManager
+	Config        *config.Config
+
+	mu sync.RWMutex
+	// subscription is the last known subscription, kept when a later check fails
+	subscription *aws.Subscription
+	// err is the error of the last check, if it failed
+	err error
+}
+
+// Start checks the subscription every check interval until the context is done
+func (m *SubscriptionMonitor) Start(ctx context.Context) error {
+	ticker := time.NewTicker(m.Config.SubscriptionCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		m.Refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection returns false so that every replica reports its readiness
+func (m *SubscriptionMonitor) NeedLeaderElection() bool {
+	return false
+}
+
+// Refresh checks the subscription and records its state. The last known subscription is kept when the check fails.
+func (m *SubscriptionMonitor) Refresh(ctx context.Context) {
+	log := log.FromContext(ctx)
+
+	subscription, err := m.ShieldManager.GetSubscription(ctx)
+	if err != nil {
+		log.Error(err, "Failed to check AWS Shield Advanced subscription")
+	} else {
+		log.V(1).Info("Checked AWS Shield Advanced subscription", "active", subscription.Active, "endTime", subscription.EndTime, "autoRenew", subscription.AutoRenew)
+		if !subscription.Active {
+			log.Info("AWS Shield Advanced subscription is not active, protections are not reconciled")
+		}
+
+		metrics.SubscriptionActive.Set(0)
+		metrics.SubscriptionEndTime.Set(0)
+		if subscription.Active {
+			metrics.SubscriptionActive.Set(1)
+		}
+		if subscription.EndTime != nil {
+			metrics.SubscriptionEndTime.Set(float64(subscription.EndTime.Unix()))
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.err = err
+	if err == nil {
+		m.subscription = subscription
+	}
+}
+
+// Check implements the readiness check, which fails until an active subscription is known or when it is known to be inactive.
+// A failed check of a subscription known to be active does not make the controller unready.
+func (m *SubscriptionMonitor) Check(_ *http.Request) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	switch {
+	case m.subscription == nil && m.err != nil:
+		return m.err
+	case m.subscription == nil:
+		return errors.New("AWS Shield Advanced subscription has not been checked yet")
+	case !m.subscription.Active:
+		return ErrSubscriptionInactive
+	}
+	return nil
+}
+
+// syncSubscriptionStatus records on the status whether the subscription ends soon, and returns ErrSubscriptionInactive
+// when the subscription is known to be inactive. A subscription that could not be checked does not prevent reconciliation,
+// so that the resulting Shield API errors are reported instead.
+func (m *SubscriptionMonitor) syncSubscriptionStatus(status *shieldawsv1alpha1.ReconcileStatus, generation int64) error {
+	if m == nil {
+		return nil
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	subscription := m.subscription
+	if subscription == nil {
+		return nil
+	}
+	if !subscription.Active {
+		return ErrSubscriptionInactive
+	}
+
+	setSubscriptionExpiring(status, generation, subscription, m.Config.SubscriptionExpiryWarning)
+
+	return nil
+}
+
+// setSubscriptionExpiring sets the expiring condition when an active subscription that is not renewed automatically ends within warning
+func setSubscriptionExpiring(status *shieldawsv1alpha1.ReconcileStatus, generation int64, subscription *aws.Subscription, warning time.Duration) {
+	if subscription.Active && !subscription.AutoRenew && subscription.EndTime != nil && time.Until(*subscription.EndTime) < warning {
+		message := fmt.Sprintf("AWS Shield Advanced subscription ends on %s and is not renewed automatically", subscription.EndTime.Format(time.RFC3339))
+		setCondition(status, generation, shieldawsv1alpha1.ConditionTypeSubscriptionExpiring, metav1.ConditionTrue, shieldawsv1alpha1.ReasonSubscriptionExpiring, message)
+	} else {
+		setCondition(status, generation, shieldawsv1alpha1.ConditionTypeSubscriptionExpiring, metav1.ConditionFalse, shieldawsv1alpha1.ReasonSucceeded, "")
+	}
+}
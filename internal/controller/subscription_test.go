@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/config"
+)
+
+// fakeSubscriptionChecker returns a fixed subscription, or fails
+type fakeSubscriptionChecker struct {
+	aws.ShieldManager
+
+	subscription *aws.Subscription
+	err          error
+}
+
+func (m *fakeSubscriptionChecker) GetSubscription(ctx context.Context) (*aws.Subscription, error) {
+	return m.subscription, m.err
+}
+
+func TestSubscriptionMonitor_RefreshFailed(t *testing.T) {
+	manager := &fakeSubscriptionChecker{err: errors.New("throttled")}
+	monitor := &SubscriptionMonitor{ShieldManager: manager, Config: &config.Config{}}
+
+	monitor.Refresh(context.Background())
+	assert.EqualError(t, monitor.Check(nil), "throttled")
+
+	manager.subscription, manager.err = &aws.Subscription{Active: true}, nil
+	monitor.Refresh(context.Background())
+	assert.NoError(t, monitor.Check(nil))
+
+	// The last known subscription is kept when a check fails
+	manager.subscription, manager.err = nil, errors.New("throttled")
+	monitor.Refresh(context.Background())
+	assert.NoError(t, monitor.Check(nil))
+	assert.NoError(t, monitor.syncSubscriptionStatus(&shieldawsv1alpha1.ReconcileStatus{}, 1))
+}
+
+func TestSubscriptionMonitor(t *testing.T) {
+	soon := time.Now().Add(24 * time.Hour)
+	later := time.Now().Add(90 * 24 * time.Hour)
+
+	tests := []struct {
+		name         string
+		subscription *aws.Subscription
+		readyErr     bool
+		inactive     bool
+		expiring     bool
+	}{
+		{
+			name:     "Not checked yet",
+			readyErr: true,
+		},
+		{
+			name:         "Inactive",
+			subscription: &aws.Subscription{Active: false},
+			readyErr:     true,
+			inactive:     true,
+		},
+		{
+			name:         "Active",
+			subscription: &aws.Subscription{Active: true, EndTime: &later},
+		},
+		{
+			name:         "Ending soon and renewed automatically",
+			subscription: &aws.Subscription{Active: true, EndTime: &soon, AutoRenew: true},
+		},
+		{
+			name:         "Ending soon and not renewed automatically",
+			subscription: &aws.Subscription{Active: true, EndTime: &soon},
+			expiring:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			monitor := &SubscriptionMonitor{
+				Config:       &config.Config{SubscriptionExpiryWarning: 30 * 24 * time.Hour},
+				subscription: tt.subscription,
+			}
+
+			if tt.readyErr {
+				assert.Error(t, monitor.Check(nil))
+			} else {
+				assert.NoError(t, monitor.Check(nil))
+			}
+
+			status := &shieldawsv1alpha1.ReconcileStatus{}
+			err := monitor.syncSubscriptionStatus(status, 1)
+			if tt.inactive {
+				assert.ErrorIs(t, err, ErrSubscriptionInactive)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expiring, meta.IsStatusConditionTrue(status.Conditions, shieldawsv1alpha1.ConditionTypeSubscriptionExpiring))
+		})
+	}
+}
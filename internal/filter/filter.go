@@ -0,0 +1,196 @@
+package filter
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+)
+
+// namePatternRegexPrefix marks an exclusion name pattern as a regular expression rather than a glob
+const namePatternRegexPrefix = "regex:"
+
+// Resources returns the discovered resources selected by the policy and the number of
+// resources that were excluded by its exclusions
+func Resources(spec *shieldawsv1alpha1.ProtectionPolicySpec, resources []aws.DiscoveredResource) ([]aws.DiscoveredResource, int, error) {
+	namePatterns, err := compileNamePatterns(spec.ExcludeNamePatterns)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	selected := []aws.DiscoveredResource{}
+	excluded := 0
+
+	for _, resource := range resources {
+		if spec.MatchTags != nil {
+			matches, err := MatchesTags(spec.MatchTags, resource.Tags)
+			if err != nil {
+				return nil, 0, fmt.Errorf("invalid matchTags: %w", err)
+			}
+			if !matches {
+				continue
+			}
+		}
+
+		if spec.ExcludeTags != nil && !isEmpty(spec.ExcludeTags) {
+			matches, err := MatchesTags(spec.ExcludeTags, resource.Tags)
+			if err != nil {
+				return nil, 0, fmt.Errorf("invalid excludeTags: %w", err)
+			}
+			if matches {
+				excluded++
+				continue
+			}
+		}
+
+		if slices.Contains(spec.ExcludeResourceArns, resource.Arn) || matchesAny(namePatterns, resource.Name) {
+			excluded++
+			continue
+		}
+
+		selected = append(selected, resource)
+	}
+
+	return selected, excluded, nil
+}
+
+// SelectsTags returns whether the policy selects or excludes resources by their tags, which then have to be discovered
+func SelectsTags(spec *shieldawsv1alpha1.ProtectionPolicySpec) bool {
+	return spec.MatchTags != nil || spec.ExcludeTags != nil
+}
+
+// ElasticIPs returns the discovery filter of the Elastic IPs selected by the selector, or nil when it is nil
+func ElasticIPs(selector *shieldawsv1alpha1.ElasticIPSelector) *aws.EIPFilter {
+	if selector == nil {
+		return nil
+	}
+
+	eipFilter := &aws.EIPFilter{
+		AssociatedOnly: selector.AssociatedOnly,
+	}
+	for _, target := range selector.AssociationTargets {
+		eipFilter.TargetTypes = append(eipFilter.TargetTypes, string(target))
+	}
+	if selector.MatchInstanceTags != nil {
+		eipFilter.MatchInstanceTags = func(tags map[string]string) (bool, error) {
+			matches, err := MatchesTags(selector.MatchInstanceTags, tags)
+			if err != nil {
+				return false, fmt.Errorf("invalid elasticIPs.matchInstanceTags: %w", err)
+			}
+			return matches, nil
+		}
+	}
+	return eipFilter
+}
+
+// UnprotectableResources returns the discovered resources that cannot be protected and are selected by the policy
+func UnprotectableResources(spec *shieldawsv1alpha1.ProtectionPolicySpec, resources []aws.UnprotectableResource) ([]aws.UnprotectableResource, error) {
+	discovered := make([]aws.DiscoveredResource, len(resources))
+	for i := range resources {
+		discovered[i] = resources[i].DiscoveredResource
+	}
+
+	selected, _, err := Resources(spec, discovered)
+	if err != nil {
+		return nil, err
+	}
+
+	unprotectable := []aws.UnprotectableResource{}
+	for _, resource := range resources {
+		if slices.ContainsFunc(selected, func(s aws.DiscoveredResource) bool { return s.Arn == resource.Arn }) {
+			unprotectable = append(unprotectable, resource)
+		}
+	}
+	return unprotectable, nil
+}
+
+// compileNamePatterns compiles the name patterns into matchers, validating globs and regular expressions
+func compileNamePatterns(patterns []string) ([]func(string) bool, error) {
+	matchers := []func(string) bool{}
+
+	for _, pattern := range patterns {
+		if expr, ok := strings.CutPrefix(pattern, namePatternRegexPrefix); ok {
+			re, err := regexp.Compile(expr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid excludeNamePatterns regular expression %q: %w", expr, err)
+			}
+			matchers = append(matchers, re.MatchString)
+			continue
+		}
+
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid excludeNamePatterns glob %q: %w", pattern, err)
+		}
+		matchers = append(matchers, func(name string) bool {
+			matches, _ := path.Match(pattern, name)
+			return matches
+		})
+	}
+
+	return matchers, nil
+}
+
+func matchesAny(matchers []func(string) bool, name string) bool {
+	for _, matches := range matchers {
+		if matches(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesTags reports whether the tags satisfy all requirements of the selector
+func MatchesTags(selector *shieldawsv1alpha1.TagSelector, tags map[string]string) (bool, error) {
+	for key, value := range selector.Tags {
+		if actual, ok := tags[key]; !ok || actual != value {
+			return false, nil
+		}
+	}
+
+	for _, requirement := range selector.Expressions {
+		matches, err := matchesRequirement(requirement, tags)
+		if err != nil {
+			return false, err
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func matchesRequirement(requirement shieldawsv1alpha1.TagSelectorRequirement, tags map[string]string) (bool, error) {
+	value, ok := tags[requirement.Key]
+
+	switch requirement.Operator {
+	case shieldawsv1alpha1.TagSelectorOpIn:
+		if len(requirement.Values) == 0 {
+			return false, fmt.Errorf("values must be set for operator %s on key %q", requirement.Operator, requirement.Key)
+		}
+		return ok && slices.Contains(requirement.Values, value), nil
+
+	case shieldawsv1alpha1.TagSelectorOpNotIn:
+		if len(requirement.Values) == 0 {
+			return false, fmt.Errorf("values must be set for operator %s on key %q", requirement.Operator, requirement.Key)
+		}
+		return !ok || !slices.Contains(requirement.Values, value), nil
+
+	case shieldawsv1alpha1.TagSelectorOpExists:
+		return ok, nil
+
+	case shieldawsv1alpha1.TagSelectorOpDoesNotExist:
+		return !ok, nil
+	}
+
+	return false, fmt.Errorf("unsupported operator %q on key %q", requirement.Operator, requirement.Key)
+}
+
+func isEmpty(selector *shieldawsv1alpha1.TagSelector) bool {
+	return len(selector.Tags) == 0 && len(selector.Expressions) == 0
+}
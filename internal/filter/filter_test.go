@@ -0,0 +1,300 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+)
+
+func TestMatchesTags(t *testing.T) {
+	tags := map[string]string{
+		"env":  "prod",
+		"team": "edge",
+	}
+
+	tests := []struct {
+		name     string
+		selector *shieldawsv1alpha1.TagSelector
+		expected bool
+		err      bool
+	}{
+		{
+			name:     "empty selector",
+			selector: &shieldawsv1alpha1.TagSelector{},
+			expected: true,
+		},
+		{
+			name:     "matching tags",
+			selector: &shieldawsv1alpha1.TagSelector{Tags: map[string]string{"env": "prod"}},
+			expected: true,
+		},
+		{
+			name:     "different tag value",
+			selector: &shieldawsv1alpha1.TagSelector{Tags: map[string]string{"env": "dev"}},
+			expected: false,
+		},
+		{
+			name:     "missing tag",
+			selector: &shieldawsv1alpha1.TagSelector{Tags: map[string]string{"owner": "me"}},
+			expected: false,
+		},
+		{
+			name: "in",
+			selector: &shieldawsv1alpha1.TagSelector{Expressions: []shieldawsv1alpha1.TagSelectorRequirement{
+				{Key: "env", Operator: shieldawsv1alpha1.TagSelectorOpIn, Values: []string{"staging", "prod"}},
+			}},
+			expected: true,
+		},
+		{
+			name: "not in",
+			selector: &shieldawsv1alpha1.TagSelector{Expressions: []shieldawsv1alpha1.TagSelectorRequirement{
+				{Key: "env", Operator: shieldawsv1alpha1.TagSelectorOpNotIn, Values: []string{"prod"}},
+			}},
+			expected: false,
+		},
+		{
+			name: "not in with missing tag",
+			selector: &shieldawsv1alpha1.TagSelector{Expressions: []shieldawsv1alpha1.TagSelectorRequirement{
+				{Key: "owner", Operator: shieldawsv1alpha1.TagSelectorOpNotIn, Values: []string{"me"}},
+			}},
+			expected: true,
+		},
+		{
+			name: "exists",
+			selector: &shieldawsv1alpha1.TagSelector{Expressions: []shieldawsv1alpha1.TagSelectorRequirement{
+				{Key: "team", Operator: shieldawsv1alpha1.TagSelectorOpExists},
+			}},
+			expected: true,
+		},
+		{
+			name: "does not exist",
+			selector: &shieldawsv1alpha1.TagSelector{Expressions: []shieldawsv1alpha1.TagSelectorRequirement{
+				{Key: "team", Operator: shieldawsv1alpha1.TagSelectorOpDoesNotExist},
+			}},
+			expected: false,
+		},
+		{
+			name: "tags and expressions",
+			selector: &shieldawsv1alpha1.TagSelector{
+				Tags: map[string]string{"env": "prod"},
+				Expressions: []shieldawsv1alpha1.TagSelectorRequirement{
+					{Key: "team", Operator: shieldawsv1alpha1.TagSelectorOpIn, Values: []string{"core"}},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "in without values",
+			selector: &shieldawsv1alpha1.TagSelector{Expressions: []shieldawsv1alpha1.TagSelectorRequirement{
+				{Key: "env", Operator: shieldawsv1alpha1.TagSelectorOpIn},
+			}},
+			err: true,
+		},
+		{
+			name: "unsupported operator",
+			selector: &shieldawsv1alpha1.TagSelector{Expressions: []shieldawsv1alpha1.TagSelectorRequirement{
+				{Key: "env", Operator: "Equals", Values: []string{"prod"}},
+			}},
+			err: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := MatchesTags(tt.selector, tags)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, matches)
+		})
+	}
+}
+
+func TestResources(t *testing.T) {
+	resources := []aws.DiscoveredResource{
+		{Arn: "arn:aws:cloudfront::123456789012:distribution/prod", Name: "prod", Tags: map[string]string{"env": "prod"}},
+		{Arn: "arn:aws:cloudfront::123456789012:distribution/prod-legacy", Name: "prod-legacy", Tags: map[string]string{"env": "prod", "legacy": "true"}},
+		{Arn: "arn:aws:cloudfront::123456789012:distribution/dev", Name: "dev", Tags: map[string]string{"env": "dev"}},
+		{Arn: "arn:aws:cloudfront::123456789012:distribution/untagged", Name: "untagged", Tags: map[string]string{}},
+	}
+
+	tests := []struct {
+		name     string
+		spec     shieldawsv1alpha1.ProtectionPolicySpec
+		expected []string
+		excluded int
+	}{
+		{
+			name: "no selectors",
+			spec: shieldawsv1alpha1.ProtectionPolicySpec{},
+			expected: []string{
+				"arn:aws:cloudfront::123456789012:distribution/prod",
+				"arn:aws:cloudfront::123456789012:distribution/prod-legacy",
+				"arn:aws:cloudfront::123456789012:distribution/dev",
+				"arn:aws:cloudfront::123456789012:distribution/untagged",
+			},
+		},
+		{
+			name: "match tags",
+			spec: shieldawsv1alpha1.ProtectionPolicySpec{
+				MatchTags: &shieldawsv1alpha1.TagSelector{Tags: map[string]string{"env": "prod"}},
+			},
+			expected: []string{
+				"arn:aws:cloudfront::123456789012:distribution/prod",
+				"arn:aws:cloudfront::123456789012:distribution/prod-legacy",
+			},
+		},
+		{
+			name: "match and exclude tags",
+			spec: shieldawsv1alpha1.ProtectionPolicySpec{
+				MatchTags: &shieldawsv1alpha1.TagSelector{Tags: map[string]string{"env": "prod"}},
+				ExcludeTags: &shieldawsv1alpha1.TagSelector{Expressions: []shieldawsv1alpha1.TagSelectorRequirement{
+					{Key: "legacy", Operator: shieldawsv1alpha1.TagSelectorOpExists},
+				}},
+			},
+			expected: []string{
+				"arn:aws:cloudfront::123456789012:distribution/prod",
+			},
+			excluded: 1,
+		},
+		{
+			name: "empty exclude tags",
+			spec: shieldawsv1alpha1.ProtectionPolicySpec{
+				MatchTags:   &shieldawsv1alpha1.TagSelector{Tags: map[string]string{"env": "dev"}},
+				ExcludeTags: &shieldawsv1alpha1.TagSelector{},
+			},
+			expected: []string{
+				"arn:aws:cloudfront::123456789012:distribution/dev",
+			},
+		},
+		{
+			name: "exclude resource arns",
+			spec: shieldawsv1alpha1.ProtectionPolicySpec{
+				ExcludeResourceArns: []string{"arn:aws:cloudfront::123456789012:distribution/dev"},
+			},
+			expected: []string{
+				"arn:aws:cloudfront::123456789012:distribution/prod",
+				"arn:aws:cloudfront::123456789012:distribution/prod-legacy",
+				"arn:aws:cloudfront::123456789012:distribution/untagged",
+			},
+			excluded: 1,
+		},
+		{
+			name: "exclude name glob",
+			spec: shieldawsv1alpha1.ProtectionPolicySpec{
+				ExcludeNamePatterns: []string{"prod*"},
+			},
+			expected: []string{
+				"arn:aws:cloudfront::123456789012:distribution/dev",
+				"arn:aws:cloudfront::123456789012:distribution/untagged",
+			},
+			excluded: 2,
+		},
+		{
+			name: "exclude name regex",
+			spec: shieldawsv1alpha1.ProtectionPolicySpec{
+				ExcludeNamePatterns: []string{"regex:^(dev|untagged)$"},
+			},
+			expected: []string{
+				"arn:aws:cloudfront::123456789012:distribution/prod",
+				"arn:aws:cloudfront::123456789012:distribution/prod-legacy",
+			},
+			excluded: 2,
+		},
+		{
+			name: "only matching resources are counted as excluded",
+			spec: shieldawsv1alpha1.ProtectionPolicySpec{
+				MatchTags:           &shieldawsv1alpha1.TagSelector{Tags: map[string]string{"env": "prod"}},
+				ExcludeNamePatterns: []string{"*"},
+			},
+			expected: []string{},
+			excluded: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, excluded, err := Resources(&tt.spec, resources)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.excluded, excluded)
+
+			arns := []string{}
+			for _, resource := range selected {
+				arns = append(arns, resource.Arn)
+			}
+			assert.Equal(t, tt.expected, arns)
+		})
+	}
+}
+
+func TestResources_InvalidNamePattern(t *testing.T) {
+	for _, pattern := range []string{"[prod", "regex:(prod"} {
+		_, _, err := Resources(&shieldawsv1alpha1.ProtectionPolicySpec{
+			ExcludeNamePatterns: []string{pattern},
+		}, []aws.DiscoveredResource{})
+		assert.Error(t, err, pattern)
+	}
+}
+
+func TestUnprotectableResources(t *testing.T) {
+	resources := []aws.UnprotectableResource{
+		{
+			DiscoveredResource: aws.DiscoveredResource{
+				Arn:  "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/prod/1111111111111111",
+				Name: "prod",
+				Tags: map[string]string{"env": "prod"},
+			},
+			Reason: aws.ReasonNoElasticIP,
+		},
+		{
+			DiscoveredResource: aws.DiscoveredResource{
+				Arn:  "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/dev/2222222222222222",
+				Name: "dev",
+				Tags: map[string]string{"env": "dev"},
+			},
+			Reason: aws.ReasonNoElasticIP,
+		},
+	}
+
+	unprotectable, err := UnprotectableResources(&shieldawsv1alpha1.ProtectionPolicySpec{
+		MatchTags: &shieldawsv1alpha1.TagSelector{Tags: map[string]string{"env": "prod"}},
+	}, resources)
+	assert.NoError(t, err)
+	assert.Equal(t, resources[:1], unprotectable)
+}
+
+func TestSelectsTags(t *testing.T) {
+	assert.False(t, SelectsTags(&shieldawsv1alpha1.ProtectionPolicySpec{ExcludeNamePatterns: []string{"test-*"}}))
+	assert.True(t, SelectsTags(&shieldawsv1alpha1.ProtectionPolicySpec{
+		MatchTags: &shieldawsv1alpha1.TagSelector{Tags: map[string]string{"env": "prod"}},
+	}))
+	assert.True(t, SelectsTags(&shieldawsv1alpha1.ProtectionPolicySpec{
+		ExcludeTags: &shieldawsv1alpha1.TagSelector{Tags: map[string]string{"env": "dev"}},
+	}))
+}
+
+func TestElasticIPs(t *testing.T) {
+	assert.Nil(t, ElasticIPs(nil))
+
+	eipFilter := ElasticIPs(&shieldawsv1alpha1.ElasticIPSelector{
+		AssociatedOnly:     true,
+		AssociationTargets: []shieldawsv1alpha1.ElasticIPAssociationTarget{shieldawsv1alpha1.ElasticIPAssociationTargetInstance},
+		MatchInstanceTags:  &shieldawsv1alpha1.TagSelector{Tags: map[string]string{"role": "web"}},
+	})
+	assert.True(t, eipFilter.AssociatedOnly)
+	assert.Equal(t, []string{aws.EIPTargetInstance}, eipFilter.TargetTypes)
+
+	matches, err := eipFilter.MatchInstanceTags(map[string]string{"role": "web"})
+	assert.NoError(t, err)
+	assert.True(t, matches)
+
+	matches, err = eipFilter.MatchInstanceTags(map[string]string{"role": "batch"})
+	assert.NoError(t, err)
+	assert.False(t, matches)
+}
@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace prefixes the names of the metrics of the controller
+const Namespace = "aws_shield_controller"
+
+var (
+	// DiscoveredResources is the number of resources discovered by the last discovery of each policy
+	DiscoveredResources = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "discovered_resources",
+		Help:      "Number of resources discovered by the last discovery of a ProtectionPolicy, per resource type and region",
+	}, []string{"namespace", "policy", "type", "region"})
+
+	// PolicyProtections is the number of protections of each policy per state
+	PolicyProtections = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "policy_protections",
+		Help:      "Number of protections of the resources matched by a ProtectionPolicy, per state",
+	}, []string{"namespace", "policy", "state"})
+
+	// UnprotectedResources is the number of resources matched by each policy that are not protected
+	UnprotectedResources = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "unprotected_resources",
+		Help:      "Number of resources matched by a ProtectionPolicy whose protection is not active",
+	}, []string{"namespace", "policy"})
+
+	// PrunedProtections is the number of protections deleted because their resource no longer matches a policy
+	PrunedProtections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "pruned_protections_total",
+		Help:      "Number of protections deleted because their resource no longer matches a ProtectionPolicy",
+	}, []string{"namespace", "policy"})
+
+	// ShieldAPIRequestDuration is the latency of the calls to the Shield API, retries included
+	ShieldAPIRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Name:      "shield_api_request_duration_seconds",
+		Help:      "Latency of the calls to the AWS Shield API per operation, retries included",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	// ShieldAPIErrors is the number of failed calls to the Shield API
+	ShieldAPIErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "shield_api_errors_total",
+		Help:      "Number of failed calls to the AWS Shield API per operation",
+	}, []string{"operation"})
+
+	// SubscriptionActive is whether the account of the controller has an active Shield Advanced subscription
+	SubscriptionActive = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "subscription_active",
+		Help:      "Whether the account of the controller has an active AWS Shield Advanced subscription",
+	})
+
+	// SubscriptionEndTime is when the current period of the Shield Advanced subscription ends
+	SubscriptionEndTime = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "subscription_end_time_seconds",
+		Help:      "Unix time at which the current period of the AWS Shield Advanced subscription ends",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		DiscoveredResources,
+		PolicyProtections,
+		UnprotectedResources,
+		PrunedProtections,
+		ShieldAPIRequestDuration,
+		ShieldAPIErrors,
+		SubscriptionActive,
+		SubscriptionEndTime,
+	)
+}
+
+// DeletePolicy removes the metrics of a policy, so that a deleted policy is not reported anymore
+func DeletePolicy(namespace, policy string) {
+	labels := prometheus.Labels{"namespace": namespace, "policy": policy}
+	DiscoveredResources.DeletePartialMatch(labels)
+	PolicyProtections.DeletePartialMatch(labels)
+	UnprotectedResources.DeletePartialMatch(labels)
+	PrunedProtections.DeletePartialMatch(labels)
+}
@@ -0,0 +1,22 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeletePolicy(t *testing.T) {
+	DiscoveredResources.WithLabelValues("default", "my-policy", "ec2/eip", "us-east-1").Set(2)
+	DiscoveredResources.WithLabelValues("default", "other-policy", "ec2/eip", "us-east-1").Set(1)
+	UnprotectedResources.WithLabelValues("default", "my-policy").Set(1)
+	PrunedProtections.WithLabelValues("default", "my-policy").Inc()
+
+	DeletePolicy("default", "my-policy")
+
+	assert.Equal(t, 1, testutil.CollectAndCount(DiscoveredResources))
+	assert.Equal(t, float64(1), testutil.ToFloat64(DiscoveredResources.WithLabelValues("default", "other-policy", "ec2/eip", "us-east-1")))
+	assert.Equal(t, 0, testutil.CollectAndCount(UnprotectedResources))
+	assert.Equal(t, 0, testutil.CollectAndCount(PrunedProtections))
+}
@@ -0,0 +1,111 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Event is the kind of change of an attack that is notified
+type Event string
+
+const (
+	// EventAttackStarted is notified when an attack is detected on a protected resource
+	EventAttackStarted Event = "AttackStarted"
+
+	// EventAttackEnded is notified when an attack on a protected resource ends
+	EventAttackEnded Event = "AttackEnded"
+)
+
+// DefaultHTTPClient is the client used to send notifications over HTTP when none is given
+var DefaultHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// Notification describes an attack that started or ended
+type Notification struct {
+	Event       Event      `json:"event"`
+	AttackId    string     `json:"attackId"`
+	ResourceArn string     `json:"resourceArn"`
+	StartTime   *time.Time `json:"startTime,omitempty"`
+	EndTime     *time.Time `json:"endTime,omitempty"`
+	Vectors     []string   `json:"vectors,omitempty"`
+	Mitigations []string   `json:"mitigations,omitempty"`
+
+	// Source identifies the Kubernetes object the attack is reported on, as kind/namespace/name
+	Source string `json:"source"`
+}
+
+// Title returns a short description of the notification
+func (n Notification) Title() string {
+	if n.Event == EventAttackEnded {
+		return fmt.Sprintf("DDoS attack ended on %s", n.ResourceArn)
+	}
+	return fmt.Sprintf("DDoS attack detected on %s", n.ResourceArn)
+}
+
+// Text returns a human readable description of the notification
+func (n Notification) Text() string {
+	var b strings.Builder
+	b.WriteString(n.Title())
+	fmt.Fprintf(&b, "\nAttack: %s", n.AttackId)
+	if n.StartTime != nil {
+		fmt.Fprintf(&b, "\nStarted: %s", n.StartTime.UTC().Format(time.RFC3339))
+	}
+	if n.EndTime != nil {
+		fmt.Fprintf(&b, "\nEnded: %s", n.EndTime.UTC().Format(time.RFC3339))
+	}
+	if len(n.Vectors) > 0 {
+		fmt.Fprintf(&b, "\nVectors: %s", strings.Join(n.Vectors, ", "))
+	}
+	if len(n.Mitigations) > 0 {
+		fmt.Fprintf(&b, "\nMitigations: %s", strings.Join(n.Mitigations, ", "))
+	}
+	fmt.Fprintf(&b, "\nReported on: %s", n.Source)
+	return b.String()
+}
+
+// Notifier sends notifications to a channel
+type Notifier interface {
+	// Notify sends the notification, and returns an error when it could not be delivered
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// postJSON posts the payload encoded as JSON to the URL, and returns an error unless the response is successful.
+// The URL is a secret, which authenticates the requests of webhook and Slack channels, so it is left out of errors.
+func postJSON(ctx context.Context, client *http.Client, endpoint string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.New("invalid webhook URL")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if client == nil {
+		client = DefaultHTTPClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("request to webhook failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("notification rejected with status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
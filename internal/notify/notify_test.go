@@ -0,0 +1,129 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+)
+
+type mockSNSClient struct {
+	mock.Mock
+}
+
+func (m *mockSNSClient) Publish(ctx context.Context, input *sns.PublishInput, opts ...func(*sns.Options)) (*sns.PublishOutput, error) {
+	args := m.Called(ctx, input, opts)
+	return args.Get(0).(*sns.PublishOutput), args.Error(1)
+}
+
+func testNotification() Notification {
+	startTime := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	return Notification{
+		Event:       EventAttackStarted,
+		AttackId:    "attack-1",
+		ResourceArn: "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/1234567890abcdef",
+		StartTime:   &startTime,
+		Vectors:     []string{"SYN_FLOOD"},
+		Source:      "Protection/default/alb",
+	}
+}
+
+// recordBodies returns a server recording the bodies of the requests it receives, and responding with status
+func recordBodies(t *testing.T, status int, bodies *[]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		*bodies = append(*bodies, string(body))
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	var bodies []string
+	server := recordBodies(t, http.StatusOK, &bodies)
+	notification := testNotification()
+
+	err := NewWebhookNotifier(server.URL, nil).Notify(context.Background(), notification)
+	assert.NoError(t, err)
+
+	assert.Len(t, bodies, 1)
+	var received Notification
+	assert.NoError(t, json.Unmarshal([]byte(bodies[0]), &received))
+	assert.Equal(t, notification, received)
+}
+
+func TestWebhookNotifier_NotifyRejected(t *testing.T) {
+	var bodies []string
+	server := recordBodies(t, http.StatusInternalServerError, &bodies)
+
+	err := NewWebhookNotifier(server.URL, nil).Notify(context.Background(), testNotification())
+	assert.ErrorContains(t, err, "status 500")
+}
+
+func TestWebhookNotifier_NotifyUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	secretURL := server.URL + "/hooks/secret-token"
+	server.Close()
+
+	err := NewWebhookNotifier(secretURL, nil).Notify(context.Background(), testNotification())
+	assert.ErrorContains(t, err, "request to webhook failed")
+	assert.NotContains(t, err.Error(), "secret-token")
+
+	err = NewWebhookNotifier("https://hooks.example.com/secret-token\x7f", nil).Notify(context.Background(), testNotification())
+	assert.EqualError(t, err, "invalid webhook URL")
+}
+
+func TestSlackNotifier_Notify(t *testing.T) {
+	var bodies []string
+	server := recordBodies(t, http.StatusOK, &bodies)
+
+	err := NewSlackNotifier(server.URL, nil).Notify(context.Background(), testNotification())
+	assert.NoError(t, err)
+
+	assert.Len(t, bodies, 1)
+	var received slackMessage
+	assert.NoError(t, json.Unmarshal([]byte(bodies[0]), &received))
+	assert.Contains(t, received.Text, "DDoS attack detected on arn:aws:elasticloadbalancing")
+	assert.Contains(t, received.Text, "Vectors: SYN_FLOOD")
+}
+
+func TestSNSNotifier_Notify(t *testing.T) {
+	mockClient := new(mockSNSClient)
+	ctx := context.Background()
+	topicArn := "arn:aws:sns:eu-west-1:123456789012:attacks"
+	notification := testNotification()
+
+	mockClient.
+		On("Publish", ctx, mock.MatchedBy(func(input *sns.PublishInput) bool {
+			var received Notification
+			return *input.TopicArn == topicArn &&
+				len(*input.Subject) <= maxSubjectLength &&
+				json.Unmarshal([]byte(*input.Message), &received) == nil &&
+				received.AttackId == notification.AttackId
+		}), mock.MatchedBy(func(opts []func(*sns.Options)) bool {
+			options := sns.Options{Region: "us-east-1"}
+			for _, opt := range opts {
+				opt(&options)
+			}
+			return options.Region == "eu-west-1"
+		})).
+		Return(&sns.PublishOutput{}, nil).
+		Once()
+
+	err := NewSNSNotifier(mockClient, topicArn).Notify(ctx, notification)
+	assert.NoError(t, err)
+
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,29 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+)
+
+// slackNotifier posts notifications as text messages to a Slack-compatible incoming webhook
+type slackNotifier struct {
+	url    string
+	client *http.Client
+}
+
+var _ Notifier = &slackNotifier{}
+
+// slackMessage is the payload of a Slack incoming webhook
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// NewSlackNotifier returns a notifier posting the notifications as messages to a Slack-compatible incoming webhook.
+// DefaultHTTPClient is used when client is nil.
+func NewSlackNotifier(url string, client *http.Client) Notifier {
+	return &slackNotifier{url: url, client: client}
+}
+
+func (n *slackNotifier) Notify(ctx context.Context, notification Notification) error {
+	return postJSON(ctx, n.client, n.url, slackMessage{Text: notification.Text()})
+}
@@ -0,0 +1,56 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+)
+
+// maxSubjectLength is the maximum length of the subject of an SNS message
+const maxSubjectLength = 100
+
+type SNSClient interface {
+	Publish(ctx context.Context, input *sns.PublishInput, opts ...func(*sns.Options)) (*sns.PublishOutput, error)
+}
+
+// snsNotifier publishes notifications as JSON messages to an SNS topic
+type snsNotifier struct {
+	client   SNSClient
+	topicArn string
+}
+
+var _ Notifier = &snsNotifier{}
+
+// NewSNSNotifier returns a notifier publishing the notifications to the SNS topic, in the region of the topic
+func NewSNSNotifier(client SNSClient, topicArn string) Notifier {
+	return &snsNotifier{client: client, topicArn: topicArn}
+}
+
+func (n *snsNotifier) Notify(ctx context.Context, notification Notification) error {
+	message, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	subject := notification.Title()
+	if len(subject) > maxSubjectLength {
+		subject = subject[:maxSubjectLength]
+	}
+
+	_, err = n.client.Publish(ctx, &sns.PublishInput{
+		TopicArn: aws.String(n.topicArn),
+		Subject:  aws.String(subject),
+		Message:  aws.String(string(message)),
+	}, n.options)
+	return err
+}
+
+// options publishes in the region of the topic
+func (n *snsNotifier) options(o *sns.Options) {
+	if topicArn, err := arn.Parse(n.topicArn); err == nil && topicArn.Region != "" {
+		o.Region = topicArn.Region
+	}
+}
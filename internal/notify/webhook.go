@@ -0,0 +1,24 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+)
+
+// webhookNotifier posts notifications as JSON to a generic HTTP webhook
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+var _ Notifier = &webhookNotifier{}
+
+// NewWebhookNotifier returns a notifier posting the notifications as JSON to the URL.
+// DefaultHTTPClient is used when client is nil.
+func NewWebhookNotifier(url string, client *http.Client) Notifier {
+	return &webhookNotifier{url: url, client: client}
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, notification Notification) error {
+	return postJSON(ctx, n.client, n.url, notification)
+}
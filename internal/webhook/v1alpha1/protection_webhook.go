@@ -0,0 +1,106 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+)
+
+// SetupProtectionWebhookWithManager registers the validating webhook of Protection with the manager,
+// validating resource ARNs against the partition of the cache
+func SetupProtectionWebhookWithManager(mgr ctrl.Manager, cache aws.Cache) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&shieldawsv1alpha1.Protection{}).
+		WithValidator(&ProtectionValidator{Client: mgr.GetClient(), Cache: cache}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-shield-aws-geode-io-v1alpha1-protection,mutating=false,failurePolicy=fail,sideEffects=None,groups=shield.aws.geode.io,resources=protections,verbs=create;update,versions=v1alpha1,name=vprotection.shield.aws.geode.io,admissionReviewVersions=v1
+
+// ProtectionValidator rejects Protections whose resource ARN is malformed, is not supported by Shield Advanced,
+// is in another partition than the controller's credentials, or is already claimed by another Protection
+type ProtectionValidator struct {
+	Client client.Client
+	Cache  aws.Cache
+}
+
+var _ admission.CustomValidator = &ProtectionValidator{}
+
+func (v *ProtectionValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	protection, ok := obj.(*shieldawsv1alpha1.Protection)
+	if !ok {
+		return nil, fmt.Errorf("expected a Protection but got a %T", obj)
+	}
+
+	return nil, v.validate(ctx, protection)
+}
+
+func (v *ProtectionValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*shieldawsv1alpha1.Protection)
+	if !ok {
+		return nil, fmt.Errorf("expected a Protection but got a %T", oldObj)
+	}
+	protection, ok := newObj.(*shieldawsv1alpha1.Protection)
+	if !ok {
+		return nil, fmt.Errorf("expected a Protection but got a %T", newObj)
+	}
+
+	// Protections admitted before the webhook was installed must still be updated, in particular to remove their finalizer
+	if old.Spec.ResourceArn == protection.Spec.ResourceArn {
+		return nil, nil
+	}
+
+	return nil, v.validate(ctx, protection)
+}
+
+func (v *ProtectionValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *ProtectionValidator) validate(ctx context.Context, protection *shieldawsv1alpha1.Protection) error {
+	path := field.NewPath("spec", "resourceArn")
+
+	if _, err := aws.ResourceTypeFromArn(protection.Spec.ResourceArn); err != nil {
+		return invalid("Protection", protection.Name, field.ErrorList{field.Invalid(path, protection.Spec.ResourceArn, err.Error())})
+	}
+
+	// The ARN parses, as its resource type was found above
+	parsed, _ := arn.Parse(protection.Spec.ResourceArn)
+	if partition := v.Cache.GetPartition(); parsed.Partition != partition {
+		return invalid("Protection", protection.Name, field.ErrorList{field.Invalid(path, protection.Spec.ResourceArn,
+			fmt.Sprintf("resource is not in partition %s of the controller", partition))})
+	}
+
+	protections := &shieldawsv1alpha1.ProtectionList{}
+	if err := v.Client.List(ctx, protections); err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("failed to list protections: %w", err))
+	}
+
+	for _, other := range protections.Items {
+		if other.Namespace == protection.Namespace && other.Name == protection.Name {
+			continue
+		}
+		if other.Spec.ResourceArn == protection.Spec.ResourceArn {
+			return invalid("Protection", protection.Name, field.ErrorList{field.Invalid(path, protection.Spec.ResourceArn,
+				fmt.Sprintf("resource is already protected by Protection %s/%s", other.Namespace, other.Name))})
+		}
+	}
+
+	return nil
+}
+
+// invalid returns the error reporting the invalid fields of an object of the API
+func invalid(kind, name string, errs field.ErrorList) error {
+	return apierrors.NewInvalid(shieldawsv1alpha1.GroupVersion.WithKind(kind).GroupKind(), name, errs)
+}
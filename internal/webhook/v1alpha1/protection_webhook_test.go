@@ -0,0 +1,101 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+)
+
+const albArn = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188"
+
+// fakeCache is the cache of credentials in a partition
+type fakeCache struct {
+	aws.Cache
+
+	partition string
+}
+
+func (c *fakeCache) GetPartition() string {
+	return c.partition
+}
+
+func newProtection(namespace, name, resourceArn string) *shieldawsv1alpha1.Protection {
+	return &shieldawsv1alpha1.Protection{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       shieldawsv1alpha1.ProtectionSpec{ResourceArn: resourceArn},
+	}
+}
+
+func TestProtectionValidator_ValidateCreate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, shieldawsv1alpha1.AddToScheme(scheme))
+
+	validator := &ProtectionValidator{
+		Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(newProtection("other", "existing", albArn)).
+			Build(),
+		Cache: &fakeCache{partition: "aws"},
+	}
+
+	tests := []struct {
+		name        string
+		protection  *shieldawsv1alpha1.Protection
+		expectedErr bool
+	}{
+		{
+			name:       "Supported resource",
+			protection: newProtection("default", "my-protection", "arn:aws:cloudfront::123456789012:distribution/EDFDVBD632BHDS5"),
+		},
+		{
+			name:        "Malformed resource ARN",
+			protection:  newProtection("default", "my-protection", "invalid-arn"),
+			expectedErr: true,
+		},
+		{
+			name:        "Unsupported resource",
+			protection:  newProtection("default", "my-protection", "arn:aws:s3:::my-bucket"),
+			expectedErr: true,
+		},
+		{
+			name:        "Resource in another partition",
+			protection:  newProtection("default", "my-protection", "arn:aws-cn:cloudfront::123456789012:distribution/EDFDVBD632BHDS5"),
+			expectedErr: true,
+		},
+		{
+			name:        "Resource claimed by another Protection",
+			protection:  newProtection("default", "my-protection", albArn),
+			expectedErr: true,
+		},
+		{
+			name:       "Resource claimed by the same Protection",
+			protection: newProtection("other", "existing", albArn),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validator.ValidateCreate(context.Background(), tt.protection)
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProtectionValidator_ValidateUpdate_UnchangedResourceArn(t *testing.T) {
+	validator := &ProtectionValidator{}
+	protection := newProtection("default", "my-protection", "invalid-arn")
+
+	_, err := validator.ValidateUpdate(context.Background(), protection, protection.DeepCopy())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,75 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+	"github.com/geode-io/aws-shield-advanced-controller/internal/aws"
+)
+
+// SetupProtectionPolicyWebhookWithManager registers the validating webhook of ProtectionPolicy with the manager
+func SetupProtectionPolicyWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&shieldawsv1alpha1.ProtectionPolicy{}).
+		WithValidator(&ProtectionPolicyValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-shield-aws-geode-io-v1alpha1-protectionpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=shield.aws.geode.io,resources=protectionpolicies,verbs=create;update,versions=v1alpha1,name=vprotectionpolicy.shield.aws.geode.io,admissionReviewVersions=v1
+
+// ProtectionPolicyValidator rejects ProtectionPolicies that match regional resource types without matching any region
+type ProtectionPolicyValidator struct{}
+
+var _ admission.CustomValidator = &ProtectionPolicyValidator{}
+
+func (v *ProtectionPolicyValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	policy, ok := obj.(*shieldawsv1alpha1.ProtectionPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expected a ProtectionPolicy but got a %T", obj)
+	}
+
+	return nil, v.validate(policy)
+}
+
+func (v *ProtectionPolicyValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	policy, ok := newObj.(*shieldawsv1alpha1.ProtectionPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expected a ProtectionPolicy but got a %T", newObj)
+	}
+
+	// Policies being deleted must still be updated to remove their finalizer
+	if !policy.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+
+	return nil, v.validate(policy)
+}
+
+func (v *ProtectionPolicyValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *ProtectionPolicyValidator) validate(policy *shieldawsv1alpha1.ProtectionPolicy) error {
+	errs := field.ErrorList{}
+
+	if len(policy.Spec.MatchRegions) == 0 {
+		for i, typ := range policy.Spec.MatchResourceTypes {
+			if aws.IsRegionalResourceType(string(typ)) {
+				errs = append(errs, field.Invalid(field.NewPath("spec", "matchResourceTypes").Index(i), typ,
+					"regional resource type requires matchRegions to be set"))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return invalid("ProtectionPolicy", policy.Name, errs)
+	}
+
+	return nil
+}
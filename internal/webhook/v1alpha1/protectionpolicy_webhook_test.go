@@ -0,0 +1,54 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	shieldawsv1alpha1 "github.com/geode-io/aws-shield-advanced-controller/api/v1alpha1"
+)
+
+func TestProtectionPolicyValidator_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        shieldawsv1alpha1.ProtectionPolicySpec
+		expectedErr bool
+	}{
+		{
+			name: "Global resource types without regions",
+			spec: shieldawsv1alpha1.ProtectionPolicySpec{
+				MatchResourceTypes: []shieldawsv1alpha1.ResourceType{"cloudfront/distribution", "route53/hostedzone"},
+			},
+		},
+		{
+			name: "Regional resource types with regions",
+			spec: shieldawsv1alpha1.ProtectionPolicySpec{
+				MatchResourceTypes: []shieldawsv1alpha1.ResourceType{"ec2/eip"},
+				MatchRegions:       []string{"us-east-1"},
+			},
+		},
+		{
+			name: "Regional resource types without regions",
+			spec: shieldawsv1alpha1.ProtectionPolicySpec{
+				MatchResourceTypes: []shieldawsv1alpha1.ResourceType{"cloudfront/distribution", "elasticloadbalancing/loadbalancer/app"},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&ProtectionPolicyValidator{}).ValidateCreate(context.Background(), &shieldawsv1alpha1.ProtectionPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "my-policy"},
+				Spec:       tt.spec,
+			})
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}